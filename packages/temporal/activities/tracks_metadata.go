@@ -2,72 +2,188 @@ package activities
 
 import (
 	"context"
-	"errors"
 	"os"
-	"time"
 
+	"github.com/AdonisEnProvence/MusicRoom/providers"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
-	"github.com/AdonisEnProvence/MusicRoom/youtube"
-	"github.com/senseyeio/duration"
 )
 
-var ErrInvalidGoogleAPIKey = errors.New("invalid Google API key")
+var ErrInvalidGoogleAPIKey = providers.ErrInvalidGoogleAPIKey
 
-func FetchTracksInformationActivity(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
-	metadata := make([]shared.TrackMetadata, 0, len(tracksIDs))
+//Only YouTube is available until the worker sets the registry
+//built from its environment
+var tracksProviders = providers.NewRegistry(providers.NewYouTubeProvider(os.Getenv("GOOGLE_API_KEY")))
 
-	if len(tracksIDs) == 0 {
-		return metadata, nil
+var tracksMetadataCache = NewTracksMetadataCache(DefaultTracksMetadataCacheSize, DefaultTracksMetadataCacheTTL)
+
+func SetTracksProvidersRegistry(registry *providers.Registry) {
+	tracksProviders = registry
+}
+
+func SetTracksMetadataCache(cache *TracksMetadataCache) {
+	tracksMetadataCache = cache
+}
+
+func GetTracksMetadataCacheStats() TracksMetadataCacheStats {
+	return tracksMetadataCache.Stats()
+}
+
+//Only the tracks missing from the cache are fetched from their provider,
+//the found ones are then cached. Returns one fetched track per requested track,
+//with its canonical id.
+func fetchTracks(ctx context.Context, tracksIDs []string) ([]providers.FetchedTrack, error) {
+	var (
+		cachedTracks     = make(map[string]shared.TrackMetadata)
+		tracksIDsToFetch = make([]string, 0, len(tracksIDs))
+		requestedKeys    = make(map[string]bool, len(tracksIDs))
+	)
+
+	for _, trackID := range tracksIDs {
+		cacheKey := tracksMetadataCacheKey(trackID)
+		if requestedKeys[cacheKey] {
+			continue
+		}
+		requestedKeys[cacheKey] = true
+
+		metadata, exists := tracksMetadataCache.Get(cacheKey)
+		if !exists {
+			tracksIDsToFetch = append(tracksIDsToFetch, trackID)
+			continue
+		}
+
+		cachedTracks[cacheKey] = metadata
+	}
+
+	//The registry returns the canonical ids of the tracks, they are matched
+	//with the requested ones through the cache key
+	fetchedTracksByKey := make(map[string]providers.FetchedTrack, len(tracksIDsToFetch))
+	if len(tracksIDsToFetch) > 0 {
+		fetchedTracks, err := tracksProviders.FetchTracks(ctx, tracksIDsToFetch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fetchedTrack := range fetchedTracks {
+			fetchedTracksByKey[tracksMetadataCacheKey(fetchedTrack.ID)] = fetchedTrack
+
+			if fetchedTrack.Status != shared.TrackFetchStatusFound {
+				continue
+			}
+
+			metadata := fetchedTrack.Metadata
+			metadata.ID = tracksMetadataCacheKey(fetchedTrack.ID)
+			//The cache is an optimization, failing to persist an entry must not fail the fetching
+			_ = tracksMetadataCache.Set(metadata)
+		}
 	}
 
-	apiKey := os.Getenv("GOOGLE_API_KEY")
-	if apiKey == "" {
-		return nil, ErrInvalidGoogleAPIKey
+	fetchedTracks := make([]providers.FetchedTrack, 0, len(tracksIDs))
+	returnedKeys := make(map[string]bool, len(tracksIDs))
+	for _, trackID := range tracksIDs {
+		cacheKey := tracksMetadataCacheKey(trackID)
+		if returnedKeys[cacheKey] {
+			continue
+		}
+
+		if metadata, isCached := cachedTracks[cacheKey]; isCached {
+			canonicalTrackID := tracksCanonicalID(trackID)
+			metadata.ID = canonicalTrackID
+
+			returnedKeys[cacheKey] = true
+			fetchedTracks = append(fetchedTracks, providers.FetchedTrack{
+				ID:       canonicalTrackID,
+				Status:   shared.TrackFetchStatusFound,
+				Metadata: metadata,
+			})
+			continue
+		}
+
+		fetchedTrack, exists := fetchedTracksByKey[cacheKey]
+		if !exists {
+			continue
+		}
+
+		returnedKeys[cacheKey] = true
+		fetchedTracks = append(fetchedTracks, fetchedTrack)
 	}
 
-	youtubeResponse, err := youtube.FetchYouTubeVideosInformation(ctx, apiKey, tracksIDs)
+	return fetchedTracks, nil
+}
+
+//Tracks are cached under their qualified id so that all the ids designating
+//a track share the same entry. Invalid ids are never cached.
+func tracksMetadataCacheKey(trackID string) string {
+	qualifiedTrackID, err := tracksProviders.QualifiedTrackID(trackID)
 	if err != nil {
-		return nil, err
+		return trackID
 	}
 
-	for _, entry := range youtubeResponse.Items {
-		parsedDuration, _ := duration.ParseISO8601(entry.ContentDetails.Duration)
+	return qualifiedTrackID
+}
 
-		trackMetadata := shared.TrackMetadata{
-			ID:         entry.ID,
-			Title:      entry.Snippet.Title,
-			ArtistName: entry.Snippet.ChannelTitle,
-			Duration:   isoDurationToDuration(parsedDuration),
-		}
+func tracksCanonicalID(trackID string) string {
+	canonicalTrackID, err := tracksProviders.CanonicalTrackID(trackID)
+	if err != nil {
+		return trackID
+	}
 
-		metadata = append(metadata, trackMetadata)
+	return canonicalTrackID
+}
+
+type FetchedTracksInformation struct {
+	Metadata []shared.TrackMetadata
+	//One result per requested track, in the requested order
+	Statuses []shared.TrackFetchResult
+}
+
+func fetchTracksInformation(ctx context.Context, tracksIDs []string) (FetchedTracksInformation, error) {
+	fetchedTracks, err := fetchTracks(ctx, tracksIDs)
+	if err != nil {
+		return FetchedTracksInformation{}, err
 	}
 
-	return metadata, nil
+	metadata := make([]shared.TrackMetadata, 0, len(fetchedTracks))
+	statuses := make([]shared.TrackFetchResult, 0, len(fetchedTracks))
+	for _, fetchedTrack := range fetchedTracks {
+		statuses = append(statuses, shared.TrackFetchResult{
+			TrackID:          fetchedTrack.ID,
+			Status:           fetchedTrack.Status,
+			UnplayableReason: fetchedTrack.UnplayableReason,
+		})
+
+		if fetchedTrack.Status == shared.TrackFetchStatusFound {
+			metadata = append(metadata, fetchedTrack.Metadata)
+		}
+	}
+
+	return FetchedTracksInformation{
+		Metadata: metadata,
+		Statuses: statuses,
+	}, nil
+}
+
+func FetchTracksInformationActivity(ctx context.Context, tracksIDs []string) (FetchedTracksInformation, error) {
+	return fetchTracksInformation(ctx, tracksIDs)
 }
 
 type FetchedTracksInformationWithInitiator struct {
 	Metadata []shared.TrackMetadata
+	//One result per requested track, in the requested order
+	Statuses []shared.TrackFetchResult
 	UserID   string
 	DeviceID string
 }
 
 func FetchTracksInformationActivityAndForwardInitiator(ctx context.Context, tracksIDs []string, userID string, deviceID string) (FetchedTracksInformationWithInitiator, error) {
-	metadata, err := FetchTracksInformationActivity(ctx, tracksIDs)
+	fetchedTracksInformation, err := fetchTracksInformation(ctx, tracksIDs)
 	if err != nil {
 		return FetchedTracksInformationWithInitiator{}, err
 	}
 
 	return FetchedTracksInformationWithInitiator{
-		Metadata: metadata,
+		Metadata: fetchedTracksInformation.Metadata,
+		Statuses: fetchedTracksInformation.Statuses,
 		UserID:   userID,
 		DeviceID: deviceID,
 	}, nil
 }
-
-func isoDurationToDuration(d duration.Duration) time.Duration {
-	now := time.Now()
-	appliedDuration := d.Shift(now)
-
-	return appliedDuration.Sub(now)
-}
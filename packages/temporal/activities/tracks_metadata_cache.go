@@ -0,0 +1,346 @@
+package activities
+
+import (
+	"bufio"
+	"container/list"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+const (
+	DefaultTracksMetadataCacheSize = 10000
+	DefaultTracksMetadataCacheTTL  = 24 * time.Hour
+
+	//The store is compacted once it holds this many times
+	//more lines than the cache can hold entries
+	tracksMetadataStoreCompactionFactor = 2
+)
+
+type TracksMetadataCacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+type tracksMetadataCacheEntry struct {
+	Metadata shared.TrackMetadata `json:"metadata"`
+	StoredAt time.Time            `json:"storedAt"`
+}
+
+//In-memory LRU cache of the tracks metadata, whose entries expire after a TTL.
+//When a store is attached, entries are also appended to a file
+//so that they survive worker restarts, the file being regularly compacted.
+type TracksMetadataCache struct {
+	mutex    sync.Mutex
+	capacity int
+	ttl      time.Duration
+	//Most recently used entries are at the front
+	lru     *list.List
+	entries map[string]*list.Element
+	store   *tracksMetadataFileStore
+
+	hits   uint64
+	misses uint64
+
+	//Variable to be able to control time in tests
+	now func() time.Time
+}
+
+func NewTracksMetadataCache(capacity int, ttl time.Duration) *TracksMetadataCache {
+	return &TracksMetadataCache{
+		capacity: capacity,
+		ttl:      ttl,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		now:      time.Now,
+	}
+}
+
+//Reads TRACKS_METADATA_CACHE_SIZE and TRACKS_METADATA_CACHE_TTL, e.g. 500 and 12h,
+//and opens the store at TRACKS_METADATA_CACHE_PATH when it is defined
+func NewTracksMetadataCacheFromEnv() (*TracksMetadataCache, error) {
+	capacity := DefaultTracksMetadataCacheSize
+	if rawCapacity := os.Getenv("TRACKS_METADATA_CACHE_SIZE"); rawCapacity != "" {
+		parsedCapacity, err := strconv.Atoi(rawCapacity)
+		if err != nil {
+			return nil, err
+		}
+
+		capacity = parsedCapacity
+	}
+
+	ttl := DefaultTracksMetadataCacheTTL
+	if rawTTL := os.Getenv("TRACKS_METADATA_CACHE_TTL"); rawTTL != "" {
+		parsedTTL, err := time.ParseDuration(rawTTL)
+		if err != nil {
+			return nil, err
+		}
+
+		ttl = parsedTTL
+	}
+
+	cache := NewTracksMetadataCache(capacity, ttl)
+
+	if storePath := os.Getenv("TRACKS_METADATA_CACHE_PATH"); storePath != "" {
+		if err := cache.OpenStore(storePath); err != nil {
+			return nil, err
+		}
+	}
+
+	return cache, nil
+}
+
+//Loads the unexpired entries of the file at path, creating it if needed,
+//and appends every new entry to it
+func (c *TracksMetadataCache) OpenStore(path string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entries, err := readTracksMetadataCacheEntries(path)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if c.isExpired(entry) {
+			continue
+		}
+
+		c.put(entry)
+	}
+
+	//Rewriting the file drops the expired, evicted and overwritten entries
+	store, err := createTracksMetadataFileStore(path, c.orderedEntries())
+	if err != nil {
+		return err
+	}
+
+	c.store = store
+
+	return nil
+}
+
+func (c *TracksMetadataCache) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.store == nil {
+		return nil
+	}
+
+	err := c.store.Close()
+	c.store = nil
+
+	return err
+}
+
+func (c *TracksMetadataCache) Get(trackID string) (shared.TrackMetadata, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, exists := c.entries[trackID]
+	if !exists {
+		atomic.AddUint64(&c.misses, 1)
+
+		return shared.TrackMetadata{}, false
+	}
+
+	entry := element.Value.(tracksMetadataCacheEntry)
+	if c.isExpired(entry) {
+		c.lru.Remove(element)
+		delete(c.entries, trackID)
+		atomic.AddUint64(&c.misses, 1)
+
+		return shared.TrackMetadata{}, false
+	}
+
+	c.lru.MoveToFront(element)
+	atomic.AddUint64(&c.hits, 1)
+
+	return entry.Metadata, true
+}
+
+func (c *TracksMetadataCache) Set(metadata shared.TrackMetadata) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry := tracksMetadataCacheEntry{
+		Metadata: metadata,
+		StoredAt: c.now(),
+	}
+	c.put(entry)
+
+	if c.store == nil {
+		return nil
+	}
+
+	if err := c.store.Append(entry); err != nil {
+		return err
+	}
+
+	if c.store.lines < tracksMetadataStoreCompactionFactor*c.capacity {
+		return nil
+	}
+
+	return c.compactStore()
+}
+
+func (c *TracksMetadataCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *TracksMetadataCache) Stats() TracksMetadataCacheStats {
+	return TracksMetadataCacheStats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
+
+func (c *TracksMetadataCache) isExpired(entry tracksMetadataCacheEntry) bool {
+	return c.now().Sub(entry.StoredAt) >= c.ttl
+}
+
+func (c *TracksMetadataCache) put(entry tracksMetadataCacheEntry) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	if element, exists := c.entries[entry.Metadata.ID]; exists {
+		element.Value = entry
+		c.lru.MoveToFront(element)
+
+		return
+	}
+
+	c.entries[entry.Metadata.ID] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.capacity {
+		leastRecentlyUsed := c.lru.Back()
+
+		c.lru.Remove(leastRecentlyUsed)
+		delete(c.entries, leastRecentlyUsed.Value.(tracksMetadataCacheEntry).Metadata.ID)
+	}
+}
+
+//Rewrites the store with the entries of the cache. Appending keeps going
+//to the previous file if it can not be rewritten.
+func (c *TracksMetadataCache) compactStore() error {
+	store, err := createTracksMetadataFileStore(c.store.path, c.orderedEntries())
+	if err != nil {
+		return err
+	}
+
+	previousStore := c.store
+	c.store = store
+
+	return previousStore.Close()
+}
+
+//From the least to the most recently used, as they must be replayed
+func (c *TracksMetadataCache) orderedEntries() []tracksMetadataCacheEntry {
+	entries := make([]tracksMetadataCacheEntry, 0, c.lru.Len())
+
+	for element := c.lru.Back(); element != nil; element = element.Prev() {
+		entries = append(entries, element.Value.(tracksMetadataCacheEntry))
+	}
+
+	return entries
+}
+
+//Append-only file with one JSON encoded entry per line,
+//the last line of a track wins when the file is read
+type tracksMetadataFileStore struct {
+	path    string
+	file    *os.File
+	encoder *json.Encoder
+	lines   int
+}
+
+func readTracksMetadataCacheEntries(path string) ([]tracksMetadataCacheEntry, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := make([]tracksMetadataCacheEntry, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry tracksMetadataCacheEntry
+
+		//A line can be truncated if the worker stopped while writing it
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil || entry.Metadata.ID == "" {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+func createTracksMetadataFileStore(path string, entries []tracksMetadataCacheEntry) (*tracksMetadataFileStore, error) {
+	temporaryFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return nil, err
+	}
+
+	encoder := json.NewEncoder(temporaryFile)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			temporaryFile.Close()
+			os.Remove(temporaryFile.Name())
+
+			return nil, err
+		}
+	}
+
+	if err := temporaryFile.Close(); err != nil {
+		os.Remove(temporaryFile.Name())
+
+		return nil, err
+	}
+	if err := os.Rename(temporaryFile.Name(), path); err != nil {
+		os.Remove(temporaryFile.Name())
+
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracksMetadataFileStore{
+		path:    path,
+		file:    file,
+		encoder: json.NewEncoder(file),
+		lines:   len(entries),
+	}, nil
+}
+
+func (s *tracksMetadataFileStore) Append(entry tracksMetadataCacheEntry) error {
+	if err := s.encoder.Encode(entry); err != nil {
+		return err
+	}
+
+	s.lines++
+
+	return nil
+}
+
+func (s *tracksMetadataFileStore) Close() error {
+	return s.file.Close()
+}
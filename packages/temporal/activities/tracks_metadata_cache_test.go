@@ -0,0 +1,255 @@
+package activities
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/providers"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/stretchr/testify/suite"
+)
+
+type TracksMetadataCacheTestSuite struct {
+	suite.Suite
+
+	now time.Time
+}
+
+func (s *TracksMetadataCacheTestSuite) SetupTest() {
+	s.now = time.Date(2021, time.November, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *TracksMetadataCacheTestSuite) newCache(capacity int, ttl time.Duration) *TracksMetadataCache {
+	cache := NewTracksMetadataCache(capacity, ttl)
+	cache.now = func() time.Time {
+		return s.now
+	}
+
+	return cache
+}
+
+func generateTrackMetadata(id string) shared.TrackMetadata {
+	return shared.TrackMetadata{
+		ID:         id,
+		Title:      "Title " + id,
+		ArtistName: "Artist",
+		Duration:   3 * time.Minute,
+	}
+}
+
+//Metadata of a track as returned by the registry
+func fetchedTrackMetadata(id string) shared.TrackMetadata {
+	metadata := generateTrackMetadata(id)
+	metadata.Provider = "counting"
+	metadata.URL = "https://counting.example.com/" + id
+
+	return metadata
+}
+
+//Serves every id that does not start with missing and counts the fetched ids
+type countingProvider struct {
+	fetchedIDs []string
+}
+
+func (p *countingProvider) Name() string {
+	return "counting"
+}
+
+func (p *countingProvider) ValidateID(id string) error {
+	return nil
+}
+
+func (p *countingProvider) FetchTracks(_ context.Context, ids []string) ([]providers.FetchedTrack, error) {
+	p.fetchedIDs = append(p.fetchedIDs, ids...)
+
+	fetchedTracks := make([]providers.FetchedTrack, 0, len(ids))
+	for _, id := range ids {
+		if strings.HasPrefix(id, "missing") {
+			fetchedTracks = append(fetchedTracks, providers.FetchedTrack{
+				ID:     id,
+				Status: shared.TrackFetchStatusNotFound,
+			})
+			continue
+		}
+
+		fetchedTracks = append(fetchedTracks, providers.FetchedTrack{
+			ID:       id,
+			Status:   shared.TrackFetchStatusFound,
+			Metadata: generateTrackMetadata(id),
+		})
+	}
+
+	return fetchedTracks, nil
+}
+
+func (p *countingProvider) PlayableURL(id string) (string, error) {
+	return "https://counting.example.com/" + id, nil
+}
+
+func (p *countingProvider) TrackIDFromURL(rawURL string) (string, bool) {
+	return "", false
+}
+
+func (s *TracksMetadataCacheTestSuite) Test_EvictsLeastRecentlyUsedTracks() {
+	cache := s.newCache(2, time.Hour)
+
+	s.NoError(cache.Set(generateTrackMetadata("a")))
+	s.NoError(cache.Set(generateTrackMetadata("b")))
+	_, exists := cache.Get("a")
+	s.True(exists)
+	s.NoError(cache.Set(generateTrackMetadata("c")))
+
+	_, exists = cache.Get("b")
+	s.False(exists)
+	metadata, exists := cache.Get("a")
+	s.True(exists)
+	s.Equal(generateTrackMetadata("a"), metadata)
+	s.Equal(2, cache.Len())
+	s.Equal(TracksMetadataCacheStats{Hits: 2, Misses: 1}, cache.Stats())
+}
+
+func (s *TracksMetadataCacheTestSuite) Test_ExpiresTracksAfterTTL() {
+	cache := s.newCache(10, time.Hour)
+
+	s.NoError(cache.Set(generateTrackMetadata("a")))
+	s.now = s.now.Add(59 * time.Minute)
+	_, exists := cache.Get("a")
+	s.True(exists)
+
+	s.now = s.now.Add(time.Minute)
+	_, exists = cache.Get("a")
+	s.False(exists)
+	s.Equal(0, cache.Len())
+}
+
+func (s *TracksMetadataCacheTestSuite) Test_StoreSurvivesRestarts() {
+	storePath := filepath.Join(s.T().TempDir(), "tracks-metadata-cache")
+
+	cache := s.newCache(10, time.Hour)
+	s.NoError(cache.OpenStore(storePath))
+	s.NoError(cache.Set(generateTrackMetadata("a")))
+	s.now = s.now.Add(30 * time.Minute)
+	s.NoError(cache.Set(generateTrackMetadata("b")))
+	s.NoError(cache.Close())
+
+	//A truncated line must not prevent the other entries from being loaded
+	file, err := os.OpenFile(storePath, os.O_WRONLY|os.O_APPEND, 0o644)
+	s.NoError(err)
+	_, err = file.WriteString(`{"metadata":{"id":"c"`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	s.now = s.now.Add(45 * time.Minute)
+	restartedCache := s.newCache(10, time.Hour)
+	s.NoError(restartedCache.OpenStore(storePath))
+	defer restartedCache.Close()
+
+	_, exists := restartedCache.Get("a")
+	s.False(exists)
+	metadata, exists := restartedCache.Get("b")
+	s.True(exists)
+	s.Equal(generateTrackMetadata("b"), metadata)
+	s.Equal(1, restartedCache.Len())
+}
+
+func (s *TracksMetadataCacheTestSuite) Test_StoreIsCompactedWhenItGrows() {
+	storePath := filepath.Join(s.T().TempDir(), "tracks-metadata-cache")
+	countStoreLines := func() int {
+		content, err := os.ReadFile(storePath)
+		s.NoError(err)
+
+		return strings.Count(string(content), "\n")
+	}
+
+	cache := s.newCache(2, time.Hour)
+	s.NoError(cache.OpenStore(storePath))
+	s.NoError(cache.Set(generateTrackMetadata("a")))
+	s.NoError(cache.Set(generateTrackMetadata("b")))
+	s.NoError(cache.Set(generateTrackMetadata("c")))
+	s.Equal(3, countStoreLines())
+
+	//Only the entries of the cache are kept
+	s.NoError(cache.Set(generateTrackMetadata("a")))
+	s.Equal(2, countStoreLines())
+
+	s.NoError(cache.Set(generateTrackMetadata("d")))
+	s.Equal(3, countStoreLines())
+	s.NoError(cache.Close())
+
+	restartedCache := s.newCache(2, time.Hour)
+	s.NoError(restartedCache.OpenStore(storePath))
+	defer restartedCache.Close()
+
+	_, exists := restartedCache.Get("c")
+	s.False(exists)
+	_, exists = restartedCache.Get("a")
+	s.True(exists)
+	_, exists = restartedCache.Get("d")
+	s.True(exists)
+}
+
+func (s *TracksMetadataCacheTestSuite) Test_TracksAreCachedUnderTheirQualifiedID() {
+	provider := &countingProvider{}
+	previousTracksProviders, previousTracksMetadataCache := tracksProviders, tracksMetadataCache
+	SetTracksProvidersRegistry(providers.NewRegistry(provider))
+	SetTracksMetadataCache(s.newCache(10, time.Hour))
+	defer func() {
+		SetTracksProvidersRegistry(previousTracksProviders)
+		SetTracksMetadataCache(previousTracksMetadataCache)
+	}()
+
+	fetched, err := FetchTracksInformationActivity(context.Background(), []string{"a"})
+	s.NoError(err)
+	s.Equal([]shared.TrackMetadata{fetchedTrackMetadata("a")}, fetched.Metadata)
+
+	//Tracks are returned with their canonical id
+	fetched, err = FetchTracksInformationActivity(context.Background(), []string{"counting:a", "a"})
+	s.NoError(err)
+	s.Equal([]shared.TrackMetadata{fetchedTrackMetadata("a")}, fetched.Metadata)
+	s.Equal([]shared.TrackFetchResult{
+		{TrackID: "a", Status: shared.TrackFetchStatusFound},
+	}, fetched.Statuses)
+
+	s.Equal([]string{"a"}, provider.fetchedIDs)
+	s.Equal(TracksMetadataCacheStats{Hits: 1, Misses: 1}, GetTracksMetadataCacheStats())
+}
+
+func (s *TracksMetadataCacheTestSuite) Test_FetchTracksInformationActivityConsultsTheCache() {
+	provider := &countingProvider{}
+	previousTracksProviders, previousTracksMetadataCache := tracksProviders, tracksMetadataCache
+	SetTracksProvidersRegistry(providers.NewRegistry(provider))
+	SetTracksMetadataCache(s.newCache(10, time.Hour))
+	defer func() {
+		SetTracksProvidersRegistry(previousTracksProviders)
+		SetTracksMetadataCache(previousTracksMetadataCache)
+	}()
+
+	fetchedInitialTracks, err := FetchTracksInformationActivity(context.Background(), []string{"a", "missing-b"})
+	s.NoError(err)
+	s.Equal([]shared.TrackMetadata{fetchedTrackMetadata("a")}, fetchedInitialTracks.Metadata)
+	s.Equal([]shared.TrackFetchResult{
+		{TrackID: "a", Status: shared.TrackFetchStatusFound},
+		{TrackID: "missing-b", Status: shared.TrackFetchStatusNotFound},
+	}, fetchedInitialTracks.Statuses)
+
+	fetched, err := FetchTracksInformationActivityAndForwardInitiator(context.Background(), []string{"c", "a", "missing-b", "a"}, "user", "device")
+	s.NoError(err)
+	s.Equal([]shared.TrackMetadata{fetchedTrackMetadata("c"), fetchedTrackMetadata("a")}, fetched.Metadata)
+	s.Equal([]shared.TrackFetchResult{
+		{TrackID: "c", Status: shared.TrackFetchStatusFound},
+		{TrackID: "a", Status: shared.TrackFetchStatusFound},
+		{TrackID: "missing-b", Status: shared.TrackFetchStatusNotFound},
+	}, fetched.Statuses)
+
+	//Unresolved tracks are not cached and fetched again
+	s.Equal([]string{"a", "missing-b", "c", "missing-b"}, provider.fetchedIDs)
+	s.Equal(TracksMetadataCacheStats{Hits: 1, Misses: 4}, GetTracksMetadataCacheStats())
+}
+
+func TestTracksMetadataCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(TracksMetadataCacheTestSuite))
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/AdonisEnProvence/MusicRoom/providers"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
 	"github.com/bojanz/httpx"
 	"github.com/gorilla/handlers"
@@ -33,6 +34,8 @@ var (
 	HTTPPort          = os.Getenv("PORT")
 	AdonisTemporalKey = os.Getenv("ADONIS_TEMPORAL_KEY")
 	temporal          client.Client
+	//Builds and parses the tracks urls of imported and exported playlists
+	tracksProviders *providers.Registry
 )
 
 func main() {
@@ -48,6 +51,11 @@ func main() {
 		log.Fatalln("unable to create Temporal client", err)
 	}
 
+	tracksProviders, err = providers.NewRegistryFromEnv()
+	if err != nil {
+		log.Fatalln("unable to create tracks providers registry", err)
+	}
+
 	r := mux.NewRouter()
 
 	r.Handle("/ping", AuthorizationMiddleware(http.HandlerFunc(PingHandler))).Methods(http.MethodGet)
@@ -1,16 +1,22 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"mime"
 	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
 	mpe "github.com/AdonisEnProvence/MusicRoom/mpe/workflows"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/playlist"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
 	"github.com/gorilla/mux"
 	"go.temporal.io/sdk/client"
@@ -18,12 +24,24 @@ import (
 
 func AddMpeHandler(r *mux.Router) {
 	r.Handle("/mpe/create", AuthorizationMiddleware(http.HandlerFunc(createMpeRoomHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/import", AuthorizationMiddleware(http.HandlerFunc(MpeImportRoomHandler))).Methods(http.MethodPost)
 	r.Handle("/mpe/add-tracks", AuthorizationMiddleware(http.HandlerFunc(MpeAddTracksHandler))).Methods(http.MethodPut)
 	r.Handle("/mpe/change-track-order", AuthorizationMiddleware(http.HandlerFunc(MpeChangeTrackOrderHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/move-track", AuthorizationMiddleware(http.HandlerFunc(MpeMoveTrackHandler))).Methods(http.MethodPut)
 	r.Handle("/mpe/delete-tracks", AuthorizationMiddleware(http.HandlerFunc(MpeDeleteTracksHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/undo", AuthorizationMiddleware(http.HandlerFunc(MpeUndoHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/redo", AuthorizationMiddleware(http.HandlerFunc(MpeRedoHandler))).Methods(http.MethodPut)
 	r.Handle("/mpe/get-state", AuthorizationMiddleware(http.HandlerFunc(getStateQueryHandler))).Methods(http.MethodPut)
 	r.Handle("/mpe/join", AuthorizationMiddleware(http.HandlerFunc(MpeJoinHandler))).Methods(http.MethodPut)
 	r.Handle("/mpe/leave", AuthorizationMiddleware(http.HandlerFunc(MpeLeaveHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/kick-user", AuthorizationMiddleware(http.HandlerFunc(MpeKickUserHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/ban-user", AuthorizationMiddleware(http.HandlerFunc(MpeBanUserHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/invite-user", AuthorizationMiddleware(http.HandlerFunc(MpeInviteUserHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/revoke-invitation", AuthorizationMiddleware(http.HandlerFunc(MpeRevokeInvitationHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/update-user-role", AuthorizationMiddleware(http.HandlerFunc(MpeUpdateUserRoleHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/pending-invitations", AuthorizationMiddleware(http.HandlerFunc(MpeGetPendingInvitationsHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/audit-log", AuthorizationMiddleware(http.HandlerFunc(MpeGetAuditLogHandler))).Methods(http.MethodPut)
+	r.Handle("/mpe/export-playlist", AuthorizationMiddleware(http.HandlerFunc(MpeExportPlaylistHandler))).Methods(http.MethodGet)
 	r.Handle("/mpe/export-to-mtv", AuthorizationMiddleware(http.HandlerFunc(MpeExportToMtvRoomHandler))).Methods(http.MethodPut)
 	r.Handle("/mpe/terminate", AuthorizationMiddleware(http.HandlerFunc(MpeTerminateHandler))).Methods(http.MethodPut)
 }
@@ -114,10 +132,6 @@ func createMpeRoomHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	options := client.StartWorkflowOptions{
-		ID:        body.WorkflowID,
-		TaskQueue: shared_mpe.ControlTaskQueue,
-	}
 	initialTrackID := body.InitialTrackID
 
 	creatorUserRelatedInformation := &shared_mpe.InternalStateUser{
@@ -135,11 +149,26 @@ func createMpeRoomHandler(w http.ResponseWriter, r *http.Request) {
 		IsOpenOnlyInvitedUsersCanEdit: body.IsOpenOnlyInvitedUsersCanEdit,
 	}
 
-	we, err := temporal.ExecuteWorkflow(context.Background(), options, mpe.MpeRoomWorkflow, params)
+	res, err := startMpeRoomWorkflow(params)
 	if err != nil {
 		WriteError(w, err)
 		return
 	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(res)
+}
+
+func startMpeRoomWorkflow(params shared_mpe.MpeRoomParameters) (MpeCreateRoomResponse, error) {
+	options := client.StartWorkflowOptions{
+		ID:        params.RoomID,
+		TaskQueue: shared_mpe.ControlTaskQueue,
+	}
+
+	we, err := temporal.ExecuteWorkflow(context.Background(), options, mpe.MpeRoomWorkflow, params)
+	if err != nil {
+		return MpeCreateRoomResponse{}, err
+	}
 	args := PerformMpeGetStateQueryArgs{
 		WorkflowID: we.GetID(),
 		RunID:      we.GetRunID(),
@@ -148,26 +177,157 @@ func createMpeRoomHandler(w http.ResponseWriter, r *http.Request) {
 
 	mpeRoomExposedState, err := PerformMpeGetStateQuery(args)
 	if err != nil {
-		WriteError(w, err)
-		return
+		return MpeCreateRoomResponse{}, err
 	}
 
-	res := MpeCreateRoomResponse{
+	return MpeCreateRoomResponse{
 		State:      mpeRoomExposedState,
 		WorkflowID: we.GetID(),
 		RunID:      we.GetRunID(),
+	}, nil
+}
+
+const (
+	maxImportedPlaylistSize      = 2 << 20
+	importedPlaylistFormFileName = "playlist"
+)
+
+var ErrNoTrackToImport = errors.New("no track could be extracted from the imported playlist")
+
+type MpeImportRoomParams struct {
+	WorkflowID string `validate:"required,uuid"`
+	UserID     string `validate:"required,uuid"`
+	Name       string `validate:"required"`
+
+	IsOpen                        bool
+	IsOpenOnlyInvitedUsersCanEdit bool
+}
+
+type MpeImportRoomResponse struct {
+	MpeCreateRoomResponse
+	//InvalidEntries are the entries of the file that do not reference a
+	//track of any provider. Tracks that could not be fetched are reported
+	//later by the workflow.
+	InvalidEntries []playlist.InvalidEntry `json:"invalidEntries"`
+}
+
+//Sent with a 422 status when no entry of the file references a track
+type MpeImportRoomErrorResponse struct {
+	ErrorResponse
+	InvalidEntries []playlist.InvalidEntry `json:"invalidEntries"`
+}
+
+func parseBoolFormValue(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+//The format form value takes precedence over the file extension
+func getImportedPlaylistFormat(r *http.Request, fileName string) (playlist.Format, error) {
+	if format := r.FormValue("format"); format != "" {
+		return playlist.ParseFormat(format)
+	}
+
+	return playlist.ParseFormat(strings.TrimPrefix(filepath.Ext(fileName), "."))
+}
+
+func MpeImportRoomHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportedPlaylistSize)
+	if err := r.ParseMultipartForm(maxImportedPlaylistSize); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	isOpen, err := parseBoolFormValue(r.FormValue("isOpen"))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	isOpenOnlyInvitedUsersCanEdit, err := parseBoolFormValue(r.FormValue("isOpenOnlyInvitedUsersCanEdit"))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	params := MpeImportRoomParams{
+		WorkflowID:                    r.FormValue("workflowID"),
+		UserID:                        r.FormValue("userID"),
+		Name:                          r.FormValue("name"),
+		IsOpen:                        isOpen,
+		IsOpenOnlyInvitedUsersCanEdit: isOpenOnlyInvitedUsersCanEdit,
+	}
+	if err := validate.Struct(params); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	file, fileHeader, err := r.FormFile(importedPlaylistFormFileName)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	defer file.Close()
+
+	format, err := getImportedPlaylistFormat(r, fileHeader.Filename)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	extracted, err := playlist.ExtractVideosIDs(file, format, tracksProviders)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if len(extracted.VideosIDs) == 0 {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(MpeImportRoomErrorResponse{
+			ErrorResponse:  ErrorResponse{Message: ErrNoTrackToImport.Error()},
+			InvalidEntries: extracted.InvalidEntries,
+		})
+		return
+	}
+
+	creatorUserRelatedInformation := &shared_mpe.InternalStateUser{
+		UserID:             params.UserID,
+		UserHasBeenInvited: true,
+	}
+
+	mpeRoomParams := shared_mpe.MpeRoomParameters{
+		RoomID:                        params.WorkflowID,
+		RoomCreatorUserID:             params.UserID,
+		RoomName:                      params.Name,
+		CreatorUserRelatedInformation: creatorUserRelatedInformation,
+		InitialTracksIDs:              extracted.VideosIDs,
+		IsOpen:                        params.IsOpen,
+		IsOpenOnlyInvitedUsersCanEdit: params.IsOpenOnlyInvitedUsersCanEdit,
+	}
+
+	res, err := startMpeRoomWorkflow(mpeRoomParams)
+	if err != nil {
+		WriteError(w, err)
+		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(res)
+	json.NewEncoder(w).Encode(MpeImportRoomResponse{
+		MpeCreateRoomResponse: res,
+		InvalidEntries:        extracted.InvalidEntries,
+	})
 }
 
 type MpeAddTracksRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
 
-	TracksIDs []string `json:"tracksIDs" validate:"required,dive,required"`
-	UserID    string   `json:"userID" validate:"required"`
-	DeviceID  string   `json:"deviceID" validate:"required"`
+	TracksIDs        []string `json:"tracksIDs" validate:"required,dive,required"`
+	UserID           string   `json:"userID" validate:"required"`
+	DeviceID         string   `json:"deviceID" validate:"required"`
+	ExpectedRevision *int     `json:"expectedRevision,omitempty" validate:"omitempty,min=0"`
 }
 
 func MpeAddTracksHandler(w http.ResponseWriter, r *http.Request) {
@@ -185,9 +345,10 @@ func MpeAddTracksHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	signal := shared_mpe.NewAddTracksSignal(shared_mpe.NewAddTracksSignalArgs{
-		TracksIDs: body.TracksIDs,
-		UserID:    body.UserID,
-		DeviceID:  body.DeviceID,
+		TracksIDs:        body.TracksIDs,
+		UserID:           body.UserID,
+		DeviceID:         body.DeviceID,
+		ExpectedRevision: body.ExpectedRevision,
 	})
 	if err := temporal.SignalWorkflow(
 		context.Background(),
@@ -233,6 +394,7 @@ type MpeChangeTrackOrderRequestBody struct {
 	DeviceID         string                              `json:"deviceID" validate:"required"`
 	OperationToApply shared_mpe.MpeOperationToApplyValue `json:"operationToApply" validate:"required"`
 	FromIndex        int                                 `json:"fromIndex" validate:"min=0"`
+	ExpectedRevision *int                                `json:"expectedRevision,omitempty" validate:"omitempty,min=0"`
 }
 
 func MpeChangeTrackOrderHandler(w http.ResponseWriter, r *http.Request) {
@@ -261,6 +423,57 @@ func MpeChangeTrackOrderHandler(w http.ResponseWriter, r *http.Request) {
 		TrackID:          body.TrackID,
 		UserID:           body.UserID,
 		FromIndex:        body.FromIndex,
+		ExpectedRevision: body.ExpectedRevision,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeMoveTrackRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	TrackID          string `json:"trackID" validate:"required"`
+	UserID           string `json:"userID" validate:"required"`
+	DeviceID         string `json:"deviceID" validate:"required"`
+	FromIndex        int    `json:"fromIndex" validate:"min=0"`
+	DestIndex        int    `json:"destIndex" validate:"min=0"`
+	ExpectedRevision *int   `json:"expectedRevision,omitempty" validate:"omitempty,min=0"`
+}
+
+func MpeMoveTrackHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeMoveTrackRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+		TrackID:          body.TrackID,
+		UserID:           body.UserID,
+		DeviceID:         body.DeviceID,
+		FromIndex:        body.FromIndex,
+		DestIndex:        body.DestIndex,
+		ExpectedRevision: body.ExpectedRevision,
 	})
 	if err := temporal.SignalWorkflow(
 		context.Background(),
@@ -282,9 +495,10 @@ func MpeChangeTrackOrderHandler(w http.ResponseWriter, r *http.Request) {
 type MpeDeleteTracksRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
 
-	TracksIDs []string `json:"tracksIDs" validate:"required,dive,required"`
-	UserID    string   `json:"userID" validate:"required,uuid"`
-	DeviceID  string   `json:"deviceID" validate:"required,uuid"`
+	TracksIDs        []string `json:"tracksIDs" validate:"required,dive,required"`
+	UserID           string   `json:"userID" validate:"required,uuid"`
+	DeviceID         string   `json:"deviceID" validate:"required,uuid"`
+	ExpectedRevision *int     `json:"expectedRevision,omitempty" validate:"omitempty,min=0"`
 }
 
 func MpeDeleteTracksHandler(w http.ResponseWriter, r *http.Request) {
@@ -302,9 +516,98 @@ func MpeDeleteTracksHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	signal := shared_mpe.NewDeleteTracksSignal(shared_mpe.NewDeleteTracksSignalArgs{
-		TracksIDs: body.TracksIDs,
-		UserID:    body.UserID,
-		DeviceID:  body.DeviceID,
+		TracksIDs:        body.TracksIDs,
+		UserID:           body.UserID,
+		DeviceID:         body.DeviceID,
+		ExpectedRevision: body.ExpectedRevision,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeUndoRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	UserID            string `json:"userID" validate:"required,uuid"`
+	DeviceID          string `json:"deviceID" validate:"required,uuid"`
+	OnlyOwnOperations bool   `json:"onlyOwnOperations"`
+}
+
+func MpeUndoHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeUndoRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewUndoSignal(shared_mpe.NewUndoSignalArgs{
+		UserID:            body.UserID,
+		DeviceID:          body.DeviceID,
+		OnlyOwnOperations: body.OnlyOwnOperations,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeRedoRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	UserID            string `json:"userID" validate:"required,uuid"`
+	DeviceID          string `json:"deviceID" validate:"required,uuid"`
+	OnlyOwnOperations bool   `json:"onlyOwnOperations"`
+}
+
+func MpeRedoHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeRedoRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewRedoSignal(shared_mpe.NewRedoSignalArgs{
+		UserID:            body.UserID,
+		DeviceID:          body.DeviceID,
+		OnlyOwnOperations: body.OnlyOwnOperations,
 	})
 	if err := temporal.SignalWorkflow(
 		context.Background(),
@@ -405,6 +708,347 @@ func MpeLeaveHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+type MpeKickUserRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	UserID       string `json:"userID" validate:"required,uuid"`
+	KickedUserID string `json:"kickedUserID" validate:"required,uuid"`
+}
+
+func MpeKickUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeKickUserRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewKickUserSignal(shared_mpe.NewKickUserSignalArgs{
+		UserID:       body.UserID,
+		KickedUserID: body.KickedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeBanUserRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	UserID       string `json:"userID" validate:"required,uuid"`
+	BannedUserID string `json:"bannedUserID" validate:"required,uuid"`
+}
+
+func MpeBanUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeBanUserRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewBanUserSignal(shared_mpe.NewBanUserSignalArgs{
+		UserID:       body.UserID,
+		BannedUserID: body.BannedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeInviteUserRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	UserID        string `json:"userID" validate:"required,uuid"`
+	InvitedUserID string `json:"invitedUserID" validate:"required,uuid"`
+}
+
+func MpeInviteUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeInviteUserRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewInviteUserSignal(shared_mpe.NewInviteUserSignalArgs{
+		UserID:        body.UserID,
+		InvitedUserID: body.InvitedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeRevokeInvitationRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	UserID        string `json:"userID" validate:"required,uuid"`
+	InvitedUserID string `json:"invitedUserID" validate:"required,uuid"`
+}
+
+func MpeRevokeInvitationHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeRevokeInvitationRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewRevokeInvitationSignal(shared_mpe.NewRevokeInvitationSignalArgs{
+		UserID:        body.UserID,
+		InvitedUserID: body.InvitedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeUpdateUserRoleRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+
+	UserID        string                     `json:"userID" validate:"required,uuid"`
+	UpdatedUserID string                     `json:"updatedUserID" validate:"required,uuid"`
+	Role          shared_mpe.MpeRoomUserRole `json:"role" validate:"required,oneof=VIEWER CONTRIBUTOR EDITOR ADMIN"`
+}
+
+func MpeUpdateUserRoleHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeUpdateUserRoleRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mpe.NewUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+		UserID:        body.UserID,
+		UpdatedUserID: body.UpdatedUserID,
+		Role:          body.Role,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mpe.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeGetPendingInvitationsRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+}
+
+func MpeGetPendingInvitationsHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeGetPendingInvitationsRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, shared.NoWorkflowRunID, shared_mpe.MpeGetPendingInvitationsQuery)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	var res []shared_mpe.MpeRoomInvitation
+	if err := response.Get(&res); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeGetAuditLogRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	Page       int    `json:"page" validate:"required,min=1"`
+	Limit      int    `json:"limit" validate:"required,min=1,max=100"`
+}
+
+func MpeGetAuditLogHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body MpeGetAuditLogRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, shared.NoWorkflowRunID, shared_mpe.MpeGetAuditLogQuery, body.Page, body.Limit)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	var res shared_mpe.MpeRoomExposedAuditLog
+	if err := response.Get(&res); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+type MpeExportPlaylistParams struct {
+	WorkflowID string `validate:"required,uuid"`
+}
+
+//The format query parameter takes precedence over the Accept header
+func getRequestedPlaylistFormat(r *http.Request) (playlist.Format, error) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return playlist.ParseFormat(format)
+	}
+
+	return playlist.NegotiateFormat(r.Header.Get("Accept"))
+}
+
+func MpeExportPlaylistHandler(w http.ResponseWriter, r *http.Request) {
+	params := MpeExportPlaylistParams{
+		WorkflowID: r.URL.Query().Get("workflowID"),
+	}
+	if err := validate.Struct(params); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	format, err := getRequestedPlaylistFormat(r)
+	if err != nil {
+		w.WriteHeader(http.StatusNotAcceptable)
+		json.NewEncoder(w).Encode(ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	mpeRoomExposedState, err := PerformMpeGetStateQuery(PerformMpeGetStateQueryArgs{
+		WorkflowID: params.WorkflowID,
+		RunID:      shared.NoWorkflowRunID,
+		UserID:     shared_mpe.NoRelatedUserID,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	exportedPlaylist := playlist.Playlist{
+		Name:   mpeRoomExposedState.RoomName,
+		Tracks: make([]shared.TrackMetadata, 0, len(mpeRoomExposedState.Tracks)),
+	}
+	for _, track := range mpeRoomExposedState.Tracks {
+		exportedPlaylist.Tracks = append(exportedPlaylist.Tracks, track.TrackMetadata)
+	}
+
+	//Encoding first so that a failure can still be reported as a json error
+	var document bytes.Buffer
+	if err := playlist.Encode(&document, format, exportedPlaylist, tracksProviders); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	fileName := fmt.Sprintf("%s.%s", mpeRoomExposedState.RoomID, format.FileExtension())
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	w.WriteHeader(http.StatusOK)
+	document.WriteTo(w)
+}
+
 type MpeExportToMtvRoomRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
 
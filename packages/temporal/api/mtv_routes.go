@@ -19,6 +19,8 @@ func AddMtvHandler(r *mux.Router) {
 	r.Handle("/mtv/create", AuthorizationMiddleware(http.HandlerFunc(CreateRoomHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/join", AuthorizationMiddleware(http.HandlerFunc(JoinRoomHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/vote-for-track", AuthorizationMiddleware(http.HandlerFunc(VoteForTrackHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/unvote-for-track", AuthorizationMiddleware(http.HandlerFunc(UnvoteForTrackHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/downvote-track", AuthorizationMiddleware(http.HandlerFunc(DownvoteTrackHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/leave", AuthorizationMiddleware(http.HandlerFunc(LeaveRoomHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/change-user-emitting-device", AuthorizationMiddleware(http.HandlerFunc(ChangeUserEmittingDeviceHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/update-user-fits-position-constraint", AuthorizationMiddleware(http.HandlerFunc(UpdateUserFitsPositionConstraintHandler))).Methods(http.MethodPut)
@@ -27,10 +29,21 @@ func AddMtvHandler(r *mux.Router) {
 	r.Handle("/mtv/terminate", AuthorizationMiddleware(http.HandlerFunc(TerminateWorkflowHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/update-delegation-owner", AuthorizationMiddleware(http.HandlerFunc(UpdateDelegationOwnerHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/update-control-and-delegation-permission", AuthorizationMiddleware(http.HandlerFunc(UpdateControlAndDelegationPermissionHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/vote-to-skip-current-track", AuthorizationMiddleware(http.HandlerFunc(VoteToSkipCurrentTrackHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/update-queue-mode", AuthorizationMiddleware(http.HandlerFunc(UpdateQueueModeHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/seek", AuthorizationMiddleware(http.HandlerFunc(SeekHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/update-settings", AuthorizationMiddleware(http.HandlerFunc(UpdateSettingsHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/transfer-ownership", AuthorizationMiddleware(http.HandlerFunc(TransferOwnershipHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/kick-user", AuthorizationMiddleware(http.HandlerFunc(KickUserHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/ban-user", AuthorizationMiddleware(http.HandlerFunc(BanUserHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/invite-user", AuthorizationMiddleware(http.HandlerFunc(InviteUserHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/revoke-invitation", AuthorizationMiddleware(http.HandlerFunc(RevokeInvitationHandler))).Methods(http.MethodPut)
 	//Queries
 	r.Handle("/mtv/room-constraints-details", AuthorizationMiddleware(http.HandlerFunc(GetRoomConstraintsDetailsHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/state", AuthorizationMiddleware(http.HandlerFunc(GetStateHandler))).Methods(http.MethodPut)
 	r.Handle("/mtv/users-list", AuthorizationMiddleware(http.HandlerFunc(GetUsersListHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/history", AuthorizationMiddleware(http.HandlerFunc(GetHistoryHandler))).Methods(http.MethodPut)
+	r.Handle("/mtv/pending-invitations", AuthorizationMiddleware(http.HandlerFunc(GetPendingInvitationsHandler))).Methods(http.MethodPut)
 }
 
 type PlayRequestBody struct {
@@ -196,6 +209,92 @@ func VoteForTrackHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+type UnvoteForTrackHandlerRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"required,uuid"`
+	TrackID    string `json:"trackID" validate:"required"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+}
+
+func UnvoteForTrackHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body UnvoteForTrackHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	unvoteForTrackSignal := shared_mtv.NewUnvoteForTrackSignal(shared_mtv.NewUnvoteForTrackSignalArgs{
+		TrackID: body.TrackID,
+		UserID:  body.UserID,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		unvoteForTrackSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type DownvoteTrackHandlerRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"required,uuid"`
+	TrackID    string `json:"trackID" validate:"required"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+}
+
+func DownvoteTrackHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body DownvoteTrackHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	downvoteTrackSignal := shared_mtv.NewDownvoteTrackSignal(shared_mtv.NewDownvoteTrackSignalArgs{
+		TrackID: body.TrackID,
+		UserID:  body.UserID,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		downvoteTrackSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
 type ChangeUserEmittingDeviceRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
 	RunID      string `json:"runID" validate:"required,uuid"`
@@ -338,6 +437,12 @@ type CreateRoomRequestBody struct {
 	HasPhysicalAndTimeConstraints bool                                          `json:"hasPhysicalAndTimeConstraints"`
 	PhysicalAndTimeConstraints    *shared_mtv.MtvRoomPhysicalAndTimeConstraints `json:"physicalAndTimeConstraints" validate:"required_if=HasPhysicalAndTimeConstraints true"`
 	PlayingMode                   shared_mtv.MtvPlayingModes                    `json:"playingMode" validate:"required"`
+	VoteToSkipThreshold           *shared_mtv.MtvRoomVoteToSkipThreshold        `json:"voteToSkipThreshold"`
+	EvictionScoreFloor            *int                                          `json:"evictionScoreFloor"`
+	SuggestionCooldown            *shared_mtv.MtvRoomSuggestionCooldown         `json:"suggestionCooldown"`
+	Autofill                      *shared_mtv.MtvRoomAutofill                   `json:"autofill"`
+	QueueMode                     shared_mtv.MtvQueueModes                      `json:"queueMode" validate:"omitempty,oneof=SCORE SHUFFLE LOOP"`
+	OwnershipTransferPolicy       shared_mtv.MtvOwnershipTransferPolicies       `json:"ownershipTransferPolicy" validate:"omitempty,oneof=OLDEST_MEMBER CONTROL_PERMISSION"`
 }
 
 type CreateRoomResponse struct {
@@ -398,6 +503,12 @@ func CreateRoomHandler(w http.ResponseWriter, r *http.Request) {
 			HasPhysicalAndTimeConstraints: body.HasPhysicalAndTimeConstraints,
 			PhysicalAndTimeConstraints:    nil,
 			PlayingMode:                   body.PlayingMode,
+			VoteToSkipThreshold:           body.VoteToSkipThreshold,
+			EvictionScoreFloor:            body.EvictionScoreFloor,
+			SuggestionCooldown:            body.SuggestionCooldown,
+			Autofill:                      body.Autofill,
+			QueueMode:                     body.QueueMode,
+			OwnershipTransferPolicy:       body.OwnershipTransferPolicy,
 		},
 	}
 
@@ -665,6 +776,397 @@ func UpdateControlAndDelegationPermissionHandler(w http.ResponseWriter, r *http.
 	json.NewEncoder(w).Encode(res)
 }
 
+type VoteToSkipCurrentTrackRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"required,uuid"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+}
+
+func VoteToSkipCurrentTrackHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body VoteToSkipCurrentTrackRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	voteToSkipCurrentTrackSignal := shared_mtv.NewVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+		UserID: body.UserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		voteToSkipCurrentTrackSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type UpdateQueueModeRequestBody struct {
+	WorkflowID string                   `json:"workflowID" validate:"required,uuid"`
+	RunID      string                   `json:"runID" validate:"required,uuid"`
+	UserID     string                   `json:"userID" validate:"required,uuid"`
+	QueueMode  shared_mtv.MtvQueueModes `json:"queueMode" validate:"required,oneof=SCORE SHUFFLE LOOP"`
+}
+
+func UpdateQueueModeHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body UpdateQueueModeRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	updateQueueModeSignal := shared_mtv.NewUpdateQueueModeSignal(shared_mtv.NewUpdateQueueModeSignalArgs{
+		UserID:    body.UserID,
+		QueueMode: body.QueueMode,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		updateQueueModeSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type SeekRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"required,uuid"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+	Position   int64  `json:"position" validate:"min=0"`
+}
+
+func SeekHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body SeekRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	seekSignal := shared_mtv.NewSeekSignal(shared_mtv.NewSeekSignalArgs{
+		UserID:   body.UserID,
+		Position: body.Position,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		seekSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type UpdateSettingsRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"required,uuid"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+
+	Name                          string                                        `json:"name" validate:"required"`
+	MinimumScoreToBePlayed        int                                           `json:"minimumScoreToBePlayed" validate:"min=0"`
+	IsOpen                        bool                                          `json:"isOpen"`
+	IsOpenOnlyInvitedUsersCanVote bool                                          `json:"isOpenOnlyInvitedUsersCanVote"`
+	HasPhysicalAndTimeConstraints bool                                          `json:"hasPhysicalAndTimeConstraints"`
+	PhysicalAndTimeConstraints    *shared_mtv.MtvRoomPhysicalAndTimeConstraints `json:"physicalAndTimeConstraints" validate:"required_if=HasPhysicalAndTimeConstraints true"`
+	PlayingMode                   shared_mtv.MtvPlayingModes                    `json:"playingMode" validate:"required,oneof=DIRECT BROADCAST"`
+}
+
+func UpdateSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body UpdateSettingsRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	updateSettingsSignal := shared_mtv.NewUpdateSettingsSignal(shared_mtv.NewUpdateSettingsSignalArgs{
+		UserID: body.UserID,
+		Settings: shared_mtv.MtvRoomSettings{
+			RoomName:                      body.Name,
+			MinimumScoreToBePlayed:        body.MinimumScoreToBePlayed,
+			IsOpen:                        body.IsOpen,
+			IsOpenOnlyInvitedUsersCanVote: body.IsOpenOnlyInvitedUsersCanVote,
+			HasPhysicalAndTimeConstraints: body.HasPhysicalAndTimeConstraints,
+			PhysicalAndTimeConstraints:    body.PhysicalAndTimeConstraints,
+			PlayingMode:                   body.PlayingMode,
+		},
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		updateSettingsSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type TransferOwnershipRequestBody struct {
+	WorkflowID     string `json:"workflowID" validate:"required,uuid"`
+	RunID          string `json:"runID" validate:"required,uuid"`
+	UserID         string `json:"userID" validate:"required,uuid"`
+	NewOwnerUserID string `json:"newOwnerUserID" validate:"required,uuid"`
+}
+
+func TransferOwnershipHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body TransferOwnershipRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	transferOwnershipSignal := shared_mtv.NewTransferOwnershipSignal(shared_mtv.NewTransferOwnershipSignalArgs{
+		UserID:         body.UserID,
+		NewOwnerUserID: body.NewOwnerUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		transferOwnershipSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type KickUserRequestBody struct {
+	WorkflowID   string `json:"workflowID" validate:"required,uuid"`
+	RunID        string `json:"runID" validate:"required,uuid"`
+	UserID       string `json:"userID" validate:"required,uuid"`
+	KickedUserID string `json:"kickedUserID" validate:"required,uuid"`
+}
+
+func KickUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body KickUserRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	kickUserSignal := shared_mtv.NewKickUserSignal(shared_mtv.NewKickUserSignalArgs{
+		UserID:       body.UserID,
+		KickedUserID: body.KickedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		kickUserSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type BanUserRequestBody struct {
+	WorkflowID   string `json:"workflowID" validate:"required,uuid"`
+	RunID        string `json:"runID" validate:"required,uuid"`
+	UserID       string `json:"userID" validate:"required,uuid"`
+	BannedUserID string `json:"bannedUserID" validate:"required,uuid"`
+}
+
+func BanUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body BanUserRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	banUserSignal := shared_mtv.NewBanUserSignal(shared_mtv.NewBanUserSignalArgs{
+		UserID:       body.UserID,
+		BannedUserID: body.BannedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		banUserSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type InviteUserRequestBody struct {
+	WorkflowID    string `json:"workflowID" validate:"required,uuid"`
+	RunID         string `json:"runID" validate:"required,uuid"`
+	UserID        string `json:"userID" validate:"required,uuid"`
+	InvitedUserID string `json:"invitedUserID" validate:"required,uuid"`
+}
+
+func InviteUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body InviteUserRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mtv.NewInviteUserSignal(shared_mtv.NewInviteUserSignalArgs{
+		UserID:        body.UserID,
+		InvitedUserID: body.InvitedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type RevokeInvitationRequestBody struct {
+	WorkflowID    string `json:"workflowID" validate:"required,uuid"`
+	RunID         string `json:"runID" validate:"required,uuid"`
+	UserID        string `json:"userID" validate:"required,uuid"`
+	InvitedUserID string `json:"invitedUserID" validate:"required,uuid"`
+}
+
+func RevokeInvitationHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body RevokeInvitationRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mtv.NewRevokeInvitationSignal(shared_mtv.NewRevokeInvitationSignalArgs{
+		UserID:        body.UserID,
+		InvitedUserID: body.InvitedUserID,
+	})
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		body.RunID,
+		shared_mtv.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
 type PerformMtvGetStateQueryArgs struct {
 	WorkflowID string
 	UserID     string
@@ -788,3 +1290,73 @@ func GetUsersListHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(res)
 }
+
+type GetHistoryBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"required,uuid"`
+	Page       int    `json:"page" validate:"required,min=1"`
+	Limit      int    `json:"limit" validate:"required,min=1,max=100"`
+}
+
+func GetHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body GetHistoryBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, body.RunID, shared_mtv.MtvGetHistoryQuery, body.Page, body.Limit)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	var res shared_mtv.MtvRoomExposedHistory
+	if err := response.Get(&res); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+type GetPendingInvitationsBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"required,uuid"`
+}
+
+func GetPendingInvitationsHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body GetPendingInvitationsBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, body.RunID, shared_mtv.MtvGetPendingInvitationsQuery)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	var res []shared_mtv.MtvRoomInvitation
+	if err := response.Get(&res); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
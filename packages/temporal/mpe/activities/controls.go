@@ -20,12 +20,16 @@ type RejectAddingTracksActivityArgs struct {
 	RoomID   string `json:"roomID"`
 	UserID   string `json:"userID"`
 	DeviceID string `json:"deviceID"`
+	//Only set when the tracks were rejected after their metadata were fetched
+	RejectedTracks []shared_mpe.RejectedTrack `json:"rejectedTracks,omitempty"`
 }
 
 type AcknowledgeAddingTracksActivityArgs struct {
 	State    shared_mpe.MpeRoomExposedState `json:"state"`
 	UserID   string                         `json:"userID"`
 	DeviceID string                         `json:"deviceID"`
+	//Tracks whose metadata could not be fetched, with the reason why
+	RejectedTracks []shared_mpe.RejectedTrack `json:"rejectedTracks"`
 }
 
 func (a *Activities) MpeCreationAcknowledgementActivity(_ context.Context, state shared_mpe.MpeRoomExposedState) error {
@@ -231,6 +235,231 @@ func (a *Activities) AcknowledgeLeaveActivity(ctx context.Context, args Acknowle
 	return err
 }
 
+type AcknowledgeKickUserActivityArgs struct {
+	State        shared_mpe.MpeRoomExposedState `json:"state"`
+	KickedUserID string                         `json:"kickedUserID"`
+	Banned       bool                           `json:"banned"`
+}
+
+func (a *Activities) AcknowledgeKickUserActivity(ctx context.Context, args AcknowledgeKickUserActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/acknowledge-kick-user"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type RejectJoinActivityArgs struct {
+	RoomID string `json:"roomID"`
+	UserID string `json:"userID"`
+}
+
+func (a *Activities) RejectJoinActivity(ctx context.Context, args RejectJoinActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/reject-join"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type AcknowledgeInviteUserActivityArgs struct {
+	State          shared_mpe.MpeRoomExposedState `json:"state"`
+	InvitingUserID string                         `json:"invitingUserID"`
+	InvitedUserID  string                         `json:"invitedUserID"`
+}
+
+func (a *Activities) AcknowledgeInviteUserActivity(ctx context.Context, args AcknowledgeInviteUserActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/acknowledge-invite-user"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type AcknowledgeRevokeInvitationActivityArgs struct {
+	State         shared_mpe.MpeRoomExposedState `json:"state"`
+	InvitedUserID string                         `json:"invitedUserID"`
+}
+
+func (a *Activities) AcknowledgeRevokeInvitationActivity(ctx context.Context, args AcknowledgeRevokeInvitationActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/acknowledge-revoke-invitation"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type AcknowledgeUpdateUserRoleActivityArgs struct {
+	State         shared_mpe.MpeRoomExposedState `json:"state"`
+	UpdatedUserID string                         `json:"updatedUserID"`
+}
+
+func (a *Activities) AcknowledgeUpdateUserRoleActivity(ctx context.Context, args AcknowledgeUpdateUserRoleActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/acknowledge-update-user-role"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+// RejectConflictingEditionActivityArgs carries the current state
+// so that the client can rebase its edition on the latest revision.
+type RejectConflictingEditionActivityArgs struct {
+	State            shared_mpe.MpeRoomExposedState `json:"state"`
+	UserID           string                         `json:"userID"`
+	DeviceID         string                         `json:"deviceID"`
+	ExpectedRevision int                            `json:"expectedRevision"`
+}
+
+func (a *Activities) RejectConflictingEditionActivity(ctx context.Context, args RejectConflictingEditionActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/reject-conflicting-edition"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type RejectUndoRedoActivityArgs struct {
+	RoomID   string `json:"roomID"`
+	UserID   string `json:"userID"`
+	DeviceID string `json:"deviceID"`
+}
+
+func (a *Activities) RejectUndoRedoActivity(ctx context.Context, args RejectUndoRedoActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/reject-undo-redo"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type ReportUnresolvedInitialTracksActivityArgs struct {
+	RoomID              string                     `json:"roomID"`
+	UserID              string                     `json:"userID"`
+	UnresolvedTracksIDs []string                   `json:"unresolvedTracksIDs"`
+	RejectedTracks      []shared_mpe.RejectedTrack `json:"rejectedTracks"`
+}
+
+func (a *Activities) ReportUnresolvedInitialTracksActivity(ctx context.Context, args ReportUnresolvedInitialTracksActivityArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := ADONIS_MPE_ENDPOINT + "/report-unresolved-initial-tracks"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
 type SendMtvRoomCreationRequestToServerActivityArgs struct {
 	TracksIDs      []string                                               `json:"tracksIDs"`
 	UserID         string                                                 `json:"userID"`
@@ -11,6 +11,9 @@ var (
 	SignalChannelName = "mpe_control"
 	MpeGetStateQuery  = "getState"
 	NoRelatedUserID   = ""
+
+	MpeGetPendingInvitationsQuery = "getPendingInvitations"
+	MpeGetAuditLogQuery           = "getAuditLog"
 )
 
 type MpeOperationToApplyValue string
@@ -32,9 +35,54 @@ func (m MpeOperationToApplyValue) IsValid() bool {
 
 var MpeOperationToApplyAllValues = [...]MpeOperationToApplyValue{MpeOperationToApplyUp, MpeOperationToApplyDown}
 
+type MpeRoomUserRole string
+
+const (
+	MpeRoomUserRoleViewer      MpeRoomUserRole = "VIEWER"
+	MpeRoomUserRoleContributor MpeRoomUserRole = "CONTRIBUTOR"
+	MpeRoomUserRoleEditor      MpeRoomUserRole = "EDITOR"
+	MpeRoomUserRoleAdmin       MpeRoomUserRole = "ADMIN"
+)
+
+func (r MpeRoomUserRole) IsValid() bool {
+	for _, role := range MpeRoomUserRoleAllValues {
+		if role == r {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanAddTracks returns true for contributors, editors and admins.
+func (r MpeRoomUserRole) CanAddTracks() bool {
+	return r == MpeRoomUserRoleContributor || r.CanEditTracks()
+}
+
+// CanEditTracks returns true if the role allows to delete and reorder tracks.
+func (r MpeRoomUserRole) CanEditTracks() bool {
+	return r == MpeRoomUserRoleEditor || r.CanManageMembers()
+}
+
+// CanManageMembers returns true if the role allows to invite, kick, ban users and update their roles.
+func (r MpeRoomUserRole) CanManageMembers() bool {
+	return r == MpeRoomUserRoleAdmin
+}
+
+var MpeRoomUserRoleAllValues = [...]MpeRoomUserRole{MpeRoomUserRoleViewer, MpeRoomUserRoleContributor, MpeRoomUserRoleEditor, MpeRoomUserRoleAdmin}
+
 type InternalStateUser struct {
-	UserID             string `json:"userID"`
-	UserHasBeenInvited bool   `json:"userHasBeenInvited"`
+	UserID             string          `json:"userID"`
+	UserHasBeenInvited bool            `json:"userHasBeenInvited"`
+	Role               MpeRoomUserRole `json:"role"`
+	//Set when the role has been given by a member manager,
+	//it is then kept when invitations change
+	RoleIsExplicit bool `json:"roleIsExplicit"`
+}
+
+type MpeRoomInvitation struct {
+	InvitedUserID  string `json:"invitedUserID"`
+	InvitingUserID string `json:"invitingUserID"`
 }
 
 type MpeRoomParameters struct {
@@ -66,20 +114,69 @@ type MpeRoomExposedState struct {
 	RoomID                        string                 `json:"roomID"`
 	RoomCreatorUserID             string                 `json:"roomCreatorUserID"`
 	RoomName                      string                 `json:"name"`
-	Tracks                        []shared.TrackMetadata `json:"tracks"`
+	Tracks                        []ExposedPlaylistTrack `json:"tracks"`
 	UsersLength                   int                    `json:"usersLength"`
 	IsOpen                        bool                   `json:"isOpen"`
 	IsOpenOnlyInvitedUsersCanEdit bool                   `json:"isOpenOnlyInvitedUsersCanEdit"`
 	PlaylistTotalDuration         int64                  `json:"playlistTotalDuration"`
 	UserRelatedInformation        *InternalStateUser     `json:"userRelatedInformation"`
+	Revision                      int                    `json:"revision"`
+}
+
+// RejectedTrack is a track that could not be added to the playlist,
+// as its metadata could not be fetched.
+type RejectedTrack struct {
+	TrackID          string                  `json:"trackID"`
+	Reason           shared.TrackFetchStatus `json:"reason"`
+	UnplayableReason string                  `json:"unplayableReason,omitempty"`
+}
+
+func RejectedTracksFromFetchResults(results []shared.TrackFetchResult) []RejectedTrack {
+	rejectedTracks := make([]RejectedTrack, 0)
+
+	for _, result := range shared.UnresolvedTrackFetchResults(results) {
+		rejectedTracks = append(rejectedTracks, RejectedTrack{
+			TrackID:          result.TrackID,
+			Reason:           result.Status,
+			UnplayableReason: result.UnplayableReason,
+		})
+	}
+
+	return rejectedTracks
+}
+
+// PlaylistTrack is a track of the playlist with the information
+// about who contributed it and when.
+type PlaylistTrack struct {
+	shared.TrackMetadata
+
+	AddedByUserID string
+	AddedAt       time.Time
+}
+
+type ExposedPlaylistTrack struct {
+	shared.TrackMetadata
+
+	AddedByUserID string `json:"addedByUserID"`
+	//Dates are stored using time.Time.Format()
+	AddedAt string `json:"addedAt"`
+}
+
+func (t PlaylistTrack) Export() ExposedPlaylistTrack {
+	return ExposedPlaylistTrack{
+		TrackMetadata: t.TrackMetadata,
+
+		AddedByUserID: t.AddedByUserID,
+		AddedAt:       t.AddedAt.Format(time.RFC3339),
+	}
 }
 
 type TrackMetadataSet struct {
-	tracks []shared.TrackMetadata
+	tracks []PlaylistTrack
 }
 
 func (s *TrackMetadataSet) Clear() {
-	s.tracks = []shared.TrackMetadata{}
+	s.tracks = []PlaylistTrack{}
 }
 
 func (s *TrackMetadataSet) GetTotalTracksDuration() int64 {
@@ -102,7 +199,7 @@ func (s *TrackMetadataSet) Has(trackID string) bool {
 	return false
 }
 
-func (s *TrackMetadataSet) Add(track shared.TrackMetadata) error {
+func (s *TrackMetadataSet) Add(track PlaylistTrack) error {
 	if isDuplicate := s.Has(track.ID); isDuplicate {
 		return errors.New("mpe add track failed, track already in set")
 	}
@@ -112,10 +209,10 @@ func (s *TrackMetadataSet) Add(track shared.TrackMetadata) error {
 }
 
 func (s *TrackMetadataSet) Init() {
-	s.tracks = []shared.TrackMetadata{}
+	s.tracks = []PlaylistTrack{}
 }
 
-func (s *TrackMetadataSet) Values() []shared.TrackMetadata {
+func (s *TrackMetadataSet) Values() []PlaylistTrack {
 	return s.tracks[:]
 }
 
@@ -162,6 +259,31 @@ func (s *TrackMetadataSet) Swap(srcIndex, destIndex int) error {
 	return nil
 }
 
+// Move removes the track at srcIndex and inserts it at destIndex,
+// tracks in between are shifted by one position.
+func (s *TrackMetadataSet) Move(srcIndex, destIndex int) error {
+	srcIndexIsInRange := s.GivenIndexFitTracksRange(srcIndex)
+	destIndexIsInRange := s.GivenIndexFitTracksRange(destIndex)
+
+	if !srcIndexIsInRange {
+		return errors.New("srcIndexIsInRange is not in tracks set range")
+	}
+
+	if !destIndexIsInRange {
+		return errors.New("destIndexIsInRange is not in tracks set range")
+	}
+
+	trackToMove := s.tracks[srcIndex]
+	if srcIndex < destIndex {
+		copy(s.tracks[srcIndex:destIndex], s.tracks[srcIndex+1:destIndex+1])
+	} else {
+		copy(s.tracks[destIndex+1:srcIndex+1], s.tracks[destIndex:srcIndex])
+	}
+	s.tracks[destIndex] = trackToMove
+
+	return nil
+}
+
 func (s *TrackMetadataSet) Delete(trackID string) {
 	for index, track := range s.tracks {
 		if track.ID == trackID {
@@ -170,3 +292,321 @@ func (s *TrackMetadataSet) Delete(trackID string) {
 		}
 	}
 }
+
+// Insert puts the track at the given index and shifts the following tracks by one position,
+// an index greater than the tracks length appends the track.
+func (s *TrackMetadataSet) Insert(index int, track PlaylistTrack) error {
+	if isDuplicate := s.Has(track.ID); isDuplicate {
+		return errors.New("mpe insert track failed, track already in set")
+	}
+
+	if index < 0 {
+		return errors.New("index is not in tracks set range")
+	}
+
+	if index > len(s.tracks) {
+		index = len(s.tracks)
+	}
+
+	s.tracks = append(s.tracks, PlaylistTrack{})
+	copy(s.tracks[index+1:], s.tracks[index:])
+	s.tracks[index] = track
+
+	return nil
+}
+
+type PlaylistOperationType string
+
+const (
+	PlaylistOperationAddTracks    PlaylistOperationType = "ADD_TRACKS"
+	PlaylistOperationDeleteTracks PlaylistOperationType = "DELETE_TRACKS"
+	PlaylistOperationMoveTrack    PlaylistOperationType = "MOVE_TRACK"
+)
+
+type PositionedTrack struct {
+	Track PlaylistTrack
+	Index int
+}
+
+// PlaylistOperation describes an edition of the tracks list
+// with enough information to apply it again or to revert it.
+type PlaylistOperation struct {
+	Type   PlaylistOperationType
+	UserID string
+
+	// Tracks are sorted by ascending index, used by add and delete operations
+	Tracks []PositionedTrack
+
+	// Used by move operations
+	TrackID   string
+	FromIndex int
+	DestIndex int
+}
+
+func (o PlaylistOperation) Inverse() PlaylistOperation {
+	inverse := o
+
+	switch o.Type {
+	case PlaylistOperationAddTracks:
+		inverse.Type = PlaylistOperationDeleteTracks
+	case PlaylistOperationDeleteTracks:
+		inverse.Type = PlaylistOperationAddTracks
+	case PlaylistOperationMoveTrack:
+		inverse.FromIndex, inverse.DestIndex = o.DestIndex, o.FromIndex
+	}
+
+	return inverse
+}
+
+// CanApplyTo returns false if the editions made since the operation
+// has been logged prevent from applying it on the tracks list.
+func (o PlaylistOperation) CanApplyTo(tracks *TrackMetadataSet) bool {
+	switch o.Type {
+	case PlaylistOperationAddTracks:
+		for _, positionedTrack := range o.Tracks {
+			if tracks.Has(positionedTrack.Track.ID) {
+				return false
+			}
+		}
+
+		return len(o.Tracks) > 0
+	case PlaylistOperationDeleteTracks:
+		for _, positionedTrack := range o.Tracks {
+			if !tracks.Has(positionedTrack.Track.ID) {
+				return false
+			}
+		}
+
+		return len(o.Tracks) > 0
+	case PlaylistOperationMoveTrack:
+		return tracks.IndexOf(o.TrackID) == o.FromIndex && tracks.GivenIndexFitTracksRange(o.DestIndex)
+	default:
+		return false
+	}
+}
+
+func (o PlaylistOperation) ApplyTo(tracks *TrackMetadataSet) error {
+	if !o.CanApplyTo(tracks) {
+		return errors.New("operation can not be applied on the tracks list")
+	}
+
+	switch o.Type {
+	case PlaylistOperationAddTracks:
+		for _, positionedTrack := range o.Tracks {
+			if err := tracks.Insert(positionedTrack.Index, positionedTrack.Track); err != nil {
+				return err
+			}
+		}
+	case PlaylistOperationDeleteTracks:
+		for _, positionedTrack := range o.Tracks {
+			tracks.Delete(positionedTrack.Track.ID)
+		}
+	case PlaylistOperationMoveTrack:
+		return tracks.Move(o.FromIndex, o.DestIndex)
+	}
+
+	return nil
+}
+
+const PlaylistOperationsLogMaxLength = 50
+
+// PlaylistOperationsLog keeps the last operations applied on the tracks list
+// so that they can be undone and redone.
+type PlaylistOperationsLog struct {
+	done   []PlaylistOperation
+	undone []PlaylistOperation
+}
+
+// Push logs a new operation, the operations that have been undone can not be redone anymore.
+func (l *PlaylistOperationsLog) Push(operation PlaylistOperation) {
+	l.done = append(l.done, operation)
+	if len(l.done) > PlaylistOperationsLogMaxLength {
+		l.done = l.done[len(l.done)-PlaylistOperationsLogMaxLength:]
+	}
+
+	l.undone = nil
+}
+
+//Returns -1 if no operation is found
+func lastOperationIndex(operations []PlaylistOperation, userID string, onlyUserOperations bool) int {
+	for index := len(operations) - 1; index >= 0; index-- {
+		if !onlyUserOperations || operations[index].UserID == userID {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func removeOperationAt(operations []PlaylistOperation, index int) []PlaylistOperation {
+	return append(operations[:index:index], operations[index+1:]...)
+}
+
+func (l *PlaylistOperationsLog) NextOperationToUndo(userID string, onlyUserOperations bool) (PlaylistOperation, bool) {
+	index := lastOperationIndex(l.done, userID, onlyUserOperations)
+	if index == -1 {
+		return PlaylistOperation{}, false
+	}
+
+	return l.done[index], true
+}
+
+func (l *PlaylistOperationsLog) NextOperationToRedo(userID string, onlyUserOperations bool) (PlaylistOperation, bool) {
+	index := lastOperationIndex(l.undone, userID, onlyUserOperations)
+	if index == -1 {
+		return PlaylistOperation{}, false
+	}
+
+	return l.undone[index], true
+}
+
+// Undo moves the operation returned by NextOperationToUndo to the undone operations,
+// reverting it on the tracks list is up to the caller.
+func (l *PlaylistOperationsLog) Undo(userID string, onlyUserOperations bool) (PlaylistOperation, bool) {
+	index := lastOperationIndex(l.done, userID, onlyUserOperations)
+	if index == -1 {
+		return PlaylistOperation{}, false
+	}
+
+	operation := l.done[index]
+	l.done = removeOperationAt(l.done, index)
+	l.undone = append(l.undone, operation)
+
+	return operation, true
+}
+
+// Redo moves the operation returned by NextOperationToRedo back to the done operations,
+// applying it again on the tracks list is up to the caller.
+func (l *PlaylistOperationsLog) Redo(userID string, onlyUserOperations bool) (PlaylistOperation, bool) {
+	index := lastOperationIndex(l.undone, userID, onlyUserOperations)
+	if index == -1 {
+		return PlaylistOperation{}, false
+	}
+
+	operation := l.undone[index]
+	l.undone = removeOperationAt(l.undone, index)
+	l.done = append(l.done, operation)
+
+	return operation, true
+}
+
+// Only the most recent editions are kept, a playlist can be edited for months
+// and the audit log is held in the workflow memory for the whole life of the room.
+const PlaylistAuditLogMaxLength = 500
+
+type PlaylistAuditLogSource string
+
+const (
+	PlaylistAuditLogSourceEdition PlaylistAuditLogSource = "EDITION"
+	PlaylistAuditLogSourceUndo    PlaylistAuditLogSource = "UNDO"
+	PlaylistAuditLogSourceRedo    PlaylistAuditLogSource = "REDO"
+)
+
+// PlaylistAuditLogEntry describes an edition applied on the tracks list,
+// Positions are the tracks indexes for add and delete operations
+// and the source and destination indexes for move operations.
+type PlaylistAuditLogEntry struct {
+	OccurredAt time.Time
+	UserID     string
+	DeviceID   string
+	Operation  PlaylistOperationType
+	Source     PlaylistAuditLogSource
+	TracksIDs  []string
+	Positions  []int
+}
+
+type NewPlaylistAuditLogEntryArgs struct {
+	OccurredAt time.Time
+	UserID     string
+	DeviceID   string
+	Operation  PlaylistOperation
+	Source     PlaylistAuditLogSource
+}
+
+func NewPlaylistAuditLogEntry(args NewPlaylistAuditLogEntryArgs) PlaylistAuditLogEntry {
+	entry := PlaylistAuditLogEntry{
+		OccurredAt: args.OccurredAt,
+		UserID:     args.UserID,
+		DeviceID:   args.DeviceID,
+		Operation:  args.Operation.Type,
+		Source:     args.Source,
+		TracksIDs:  make([]string, 0, len(args.Operation.Tracks)),
+		Positions:  make([]int, 0, len(args.Operation.Tracks)),
+	}
+
+	if args.Operation.Type == PlaylistOperationMoveTrack {
+		entry.TracksIDs = append(entry.TracksIDs, args.Operation.TrackID)
+		entry.Positions = append(entry.Positions, args.Operation.FromIndex, args.Operation.DestIndex)
+
+		return entry
+	}
+
+	for _, positionedTrack := range args.Operation.Tracks {
+		entry.TracksIDs = append(entry.TracksIDs, positionedTrack.Track.ID)
+		entry.Positions = append(entry.Positions, positionedTrack.Index)
+	}
+
+	return entry
+}
+
+type ExposedPlaylistAuditLogEntry struct {
+	//Dates are stored using time.Time.Format()
+	OccurredAt string                 `json:"occurredAt"`
+	UserID     string                 `json:"userID"`
+	DeviceID   string                 `json:"deviceID"`
+	Operation  PlaylistOperationType  `json:"operation"`
+	Source     PlaylistAuditLogSource `json:"source"`
+	TracksIDs  []string               `json:"tracksIDs"`
+	Positions  []int                  `json:"positions"`
+}
+
+func (e PlaylistAuditLogEntry) Export() ExposedPlaylistAuditLogEntry {
+	return ExposedPlaylistAuditLogEntry{
+		OccurredAt: e.OccurredAt.Format(time.RFC3339),
+		UserID:     e.UserID,
+		DeviceID:   e.DeviceID,
+		Operation:  e.Operation,
+		Source:     e.Source,
+		TracksIDs:  e.TracksIDs,
+		Positions:  e.Positions,
+	}
+}
+
+// PlaylistAuditLog stores the editions in the order they have been applied.
+type PlaylistAuditLog struct {
+	entries []PlaylistAuditLogEntry
+}
+
+func (l *PlaylistAuditLog) Len() int {
+	return len(l.entries)
+}
+
+// Add appends the entry to the audit log and drops the oldest entry
+// when the audit log is full.
+func (l *PlaylistAuditLog) Add(entry PlaylistAuditLogEntry) {
+	l.entries = append(l.entries, entry)
+
+	if overflow := len(l.entries) - PlaylistAuditLogMaxLength; overflow > 0 {
+		l.entries = l.entries[overflow:]
+	}
+}
+
+// Page returns the requested page of the audit log, most recent editions first,
+// and whether there are more entries after this page.
+// Pages start at 1.
+func (l *PlaylistAuditLog) Page(page int, limit int) ([]PlaylistAuditLogEntry, bool) {
+	indexes, hasMore := shared.MostRecentFirstPageIndexes(len(l.entries), page, limit)
+
+	pageEntries := make([]PlaylistAuditLogEntry, 0, len(indexes))
+	for _, index := range indexes {
+		pageEntries = append(pageEntries, l.entries[index])
+	}
+
+	return pageEntries, hasMore
+}
+
+type MpeRoomExposedAuditLog struct {
+	Entries []ExposedPlaylistAuditLogEntry `json:"entries"`
+	Page    int                            `json:"page"`
+	HasMore bool                           `json:"hasMore"`
+}
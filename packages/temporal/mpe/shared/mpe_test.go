@@ -0,0 +1,252 @@
+package shared_mpe_test
+
+import (
+	"testing"
+	"time"
+
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+func generatePlaylistTracks(count int) []shared_mpe.PlaylistTrack {
+	tracks := make([]shared_mpe.PlaylistTrack, 0, count)
+
+	for index := 0; index < count; index++ {
+		tracks = append(tracks, shared_mpe.PlaylistTrack{
+			TrackMetadata: shared.TrackMetadata{
+				ID:         faker.UUIDHyphenated(),
+				Title:      faker.Word(),
+				ArtistName: faker.Name(),
+				Duration:   random.GenerateRandomDuration(),
+			},
+			AddedByUserID: faker.UUIDHyphenated(),
+			AddedAt:       time.Now(),
+		})
+	}
+
+	return tracks
+}
+
+func (s *UnitTestSuite) Test_TrackMetadataSetMovesTrackForward() {
+	var set shared_mpe.TrackMetadataSet
+	tracks := generatePlaylistTracks(5)
+
+	set.Init()
+	for _, track := range tracks {
+		set.Add(track)
+	}
+
+	err := set.Move(1, 3)
+	s.NoError(err)
+
+	expectedTracks := []shared_mpe.PlaylistTrack{
+		tracks[0],
+		tracks[2],
+		tracks[3],
+		tracks[1],
+		tracks[4],
+	}
+	s.Equal(expectedTracks, set.Values())
+}
+
+func (s *UnitTestSuite) Test_TrackMetadataSetMovesTrackBackward() {
+	var set shared_mpe.TrackMetadataSet
+	tracks := generatePlaylistTracks(5)
+
+	set.Init()
+	for _, track := range tracks {
+		set.Add(track)
+	}
+
+	err := set.Move(4, 0)
+	s.NoError(err)
+
+	expectedTracks := []shared_mpe.PlaylistTrack{
+		tracks[4],
+		tracks[0],
+		tracks[1],
+		tracks[2],
+		tracks[3],
+	}
+	s.Equal(expectedTracks, set.Values())
+}
+
+func (s *UnitTestSuite) Test_TrackMetadataSetMoveFailsOutOfRange() {
+	var set shared_mpe.TrackMetadataSet
+	tracks := generatePlaylistTracks(3)
+
+	set.Init()
+	for _, track := range tracks {
+		set.Add(track)
+	}
+
+	s.Error(set.Move(-1, 0))
+	s.Error(set.Move(0, 3))
+	s.Equal(tracks, set.Values())
+
+	// Moving a track to its own position is a no-op
+	s.NoError(set.Move(1, 1))
+	s.Equal(tracks, set.Values())
+}
+
+func (s *UnitTestSuite) Test_RevertingDeleteTracksOperationRestoresTracksPositions() {
+	var set shared_mpe.TrackMetadataSet
+	tracks := generatePlaylistTracks(5)
+
+	set.Init()
+	for _, track := range tracks {
+		set.Add(track)
+	}
+
+	operation := shared_mpe.PlaylistOperation{
+		Type: shared_mpe.PlaylistOperationDeleteTracks,
+		Tracks: []shared_mpe.PositionedTrack{
+			{Track: tracks[1], Index: 1},
+			{Track: tracks[4], Index: 4},
+		},
+	}
+
+	err := operation.ApplyTo(&set)
+	s.NoError(err)
+	s.Equal([]shared_mpe.PlaylistTrack{tracks[0], tracks[2], tracks[3]}, set.Values())
+
+	err = operation.Inverse().ApplyTo(&set)
+	s.NoError(err)
+	s.Equal(tracks, set.Values())
+
+	// The tracks are already in the set
+	err = operation.Inverse().ApplyTo(&set)
+	s.Error(err)
+}
+
+func (s *UnitTestSuite) Test_PlaylistOperationsLogUndoesUserOperations() {
+	var log shared_mpe.PlaylistOperationsLog
+	firstUserID := faker.UUIDHyphenated()
+	secondUserID := faker.UUIDHyphenated()
+
+	firstUserOperation := shared_mpe.PlaylistOperation{
+		Type:   shared_mpe.PlaylistOperationMoveTrack,
+		UserID: firstUserID,
+	}
+	secondUserOperation := shared_mpe.PlaylistOperation{
+		Type:   shared_mpe.PlaylistOperationMoveTrack,
+		UserID: secondUserID,
+	}
+	log.Push(firstUserOperation)
+	log.Push(secondUserOperation)
+
+	operation, ok := log.Undo(firstUserID, true)
+	s.True(ok)
+	s.Equal(firstUserOperation, operation)
+
+	_, ok = log.Undo(firstUserID, true)
+	s.False(ok)
+
+	operation, ok = log.NextOperationToUndo(firstUserID, false)
+	s.True(ok)
+	s.Equal(secondUserOperation, operation)
+
+	operation, ok = log.NextOperationToRedo(secondUserID, false)
+	s.True(ok)
+	s.Equal(firstUserOperation, operation)
+
+	// A new operation discards the undone operations
+	log.Push(secondUserOperation)
+	_, ok = log.NextOperationToRedo(firstUserID, false)
+	s.False(ok)
+}
+
+func (s *UnitTestSuite) Test_PlaylistOperationsLogIsBounded() {
+	var log shared_mpe.PlaylistOperationsLog
+	userID := faker.UUIDHyphenated()
+
+	for index := 0; index < shared_mpe.PlaylistOperationsLogMaxLength+10; index++ {
+		log.Push(shared_mpe.PlaylistOperation{
+			Type:      shared_mpe.PlaylistOperationMoveTrack,
+			UserID:    userID,
+			FromIndex: index,
+		})
+	}
+
+	undoneOperationsCount := 0
+	for {
+		if _, ok := log.Undo(userID, false); !ok {
+			break
+		}
+		undoneOperationsCount++
+	}
+
+	s.Equal(shared_mpe.PlaylistOperationsLogMaxLength, undoneOperationsCount)
+}
+
+func (s *UnitTestSuite) Test_PlaylistAuditLogPagination() {
+	var auditLog shared_mpe.PlaylistAuditLog
+
+	usersIDs := make([]string, 0, shared_mpe.PlaylistAuditLogMaxLength+2)
+	for index := 0; index < shared_mpe.PlaylistAuditLogMaxLength+2; index++ {
+		entry := shared_mpe.PlaylistAuditLogEntry{
+			UserID:    faker.UUIDHyphenated(),
+			Operation: shared_mpe.PlaylistOperationAddTracks,
+		}
+
+		auditLog.Add(entry)
+		usersIDs = append(usersIDs, entry.UserID)
+	}
+
+	// Oldest entries are dropped
+	s.Equal(shared_mpe.PlaylistAuditLogMaxLength, auditLog.Len())
+
+	firstPage, hasMore := auditLog.Page(1, 2)
+	s.True(hasMore)
+	s.Len(firstPage, 2)
+	// Most recent editions first
+	s.Equal(usersIDs[len(usersIDs)-1], firstPage[0].UserID)
+	s.Equal(usersIDs[len(usersIDs)-2], firstPage[1].UserID)
+
+	lastPage, hasMore := auditLog.Page(shared_mpe.PlaylistAuditLogMaxLength/2, 2)
+	s.False(hasMore)
+	s.Len(lastPage, 2)
+	s.Equal(usersIDs[2], lastPage[1].UserID)
+
+	outOfRangePage, hasMore := auditLog.Page(shared_mpe.PlaylistAuditLogMaxLength, 2)
+	s.False(hasMore)
+	s.Empty(outOfRangePage)
+}
+
+func (s *UnitTestSuite) Test_PlaylistAuditLogEntryPositions() {
+	tracks := generatePlaylistTracks(2)
+
+	deleteEntry := shared_mpe.NewPlaylistAuditLogEntry(shared_mpe.NewPlaylistAuditLogEntryArgs{
+		Operation: shared_mpe.PlaylistOperation{
+			Type: shared_mpe.PlaylistOperationDeleteTracks,
+			Tracks: []shared_mpe.PositionedTrack{
+				{Track: tracks[0], Index: 2},
+				{Track: tracks[1], Index: 5},
+			},
+		},
+	})
+	s.Equal([]string{tracks[0].ID, tracks[1].ID}, deleteEntry.TracksIDs)
+	s.Equal([]int{2, 5}, deleteEntry.Positions)
+
+	moveEntry := shared_mpe.NewPlaylistAuditLogEntry(shared_mpe.NewPlaylistAuditLogEntryArgs{
+		Operation: shared_mpe.PlaylistOperation{
+			Type:      shared_mpe.PlaylistOperationMoveTrack,
+			TrackID:   tracks[0].ID,
+			FromIndex: 3,
+			DestIndex: 1,
+		},
+	})
+	s.Equal([]string{tracks[0].ID}, moveEntry.TracksIDs)
+	s.Equal([]int{3, 1}, moveEntry.Positions)
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
@@ -8,9 +8,17 @@ import (
 const (
 	SignalAddTracks         shared.SignalRoute = "add-tracks"
 	SignalChangeTrackOrder  shared.SignalRoute = "change-track-order"
+	SignalMoveTrack         shared.SignalRoute = "move-track"
 	SignalDeleteTracks      shared.SignalRoute = "delete-tracks"
+	SignalUndo              shared.SignalRoute = "undo"
+	SignalRedo              shared.SignalRoute = "redo"
 	SignalAddUser           shared.SignalRoute = "add-user"
 	SignalRemoveUser        shared.SignalRoute = "remove-user"
+	SignalKickUser          shared.SignalRoute = "kick-user"
+	SignalBanUser           shared.SignalRoute = "ban-user"
+	SignalInviteUser        shared.SignalRoute = "invite-user"
+	SignalRevokeInvitation  shared.SignalRoute = "revoke-invitation"
+	SignalUpdateUserRole    shared.SignalRoute = "update-user-role"
 	SignalExportToMtvRoom   shared.SignalRoute = "export-to-mtv-room"
 	SignalTerminateWorkflow shared.SignalRoute = "terminate-workflow"
 )
@@ -20,20 +28,25 @@ type AddTracksSignal struct {
 	TracksIDs []string           `validate:"required,dive,required"`
 	UserID    string             `validate:"required"`
 	DeviceID  string             `validate:"required"`
+	// ExpectedRevision is the playlist revision the edition is based on,
+	// the edition is not checked against the current revision when nil.
+	ExpectedRevision *int `validate:"omitempty,min=0"`
 }
 
 type NewAddTracksSignalArgs struct {
-	TracksIDs []string
-	UserID    string
-	DeviceID  string
+	TracksIDs        []string
+	UserID           string
+	DeviceID         string
+	ExpectedRevision *int
 }
 
 func NewAddTracksSignal(args NewAddTracksSignalArgs) AddTracksSignal {
 	return AddTracksSignal{
-		Route:     SignalAddTracks,
-		TracksIDs: args.TracksIDs,
-		UserID:    args.UserID,
-		DeviceID:  args.DeviceID,
+		Route:            SignalAddTracks,
+		TracksIDs:        args.TracksIDs,
+		UserID:           args.UserID,
+		DeviceID:         args.DeviceID,
+		ExpectedRevision: args.ExpectedRevision,
 	}
 }
 
@@ -45,6 +58,7 @@ type ChangeTrackOrderSignal struct {
 	DeviceID         string                   `validate:"required"`
 	OperationToApply MpeOperationToApplyValue `validate:"required"`
 	FromIndex        int                      `validate:"min=0"`
+	ExpectedRevision *int                     `validate:"omitempty,min=0"`
 }
 
 type NewChangeTrackOrderSignalArgs struct {
@@ -53,6 +67,7 @@ type NewChangeTrackOrderSignalArgs struct {
 	DeviceID         string
 	OperationToApply MpeOperationToApplyValue
 	FromIndex        int `validate:"min=0"`
+	ExpectedRevision *int
 }
 
 func NewChangeTrackOrderSignal(args NewChangeTrackOrderSignalArgs) ChangeTrackOrderSignal {
@@ -63,30 +78,116 @@ func NewChangeTrackOrderSignal(args NewChangeTrackOrderSignalArgs) ChangeTrackOr
 		DeviceID:         args.DeviceID,
 		OperationToApply: args.OperationToApply,
 		FromIndex:        args.FromIndex,
+		ExpectedRevision: args.ExpectedRevision,
+	}
+}
+
+type MoveTrackSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	TrackID          string `validate:"required"`
+	UserID           string `validate:"required"`
+	DeviceID         string `validate:"required"`
+	FromIndex        int    `validate:"min=0"`
+	DestIndex        int    `validate:"min=0"`
+	ExpectedRevision *int   `validate:"omitempty,min=0"`
+}
+
+type NewMoveTrackSignalArgs struct {
+	TrackID          string
+	UserID           string
+	DeviceID         string
+	FromIndex        int `validate:"min=0"`
+	DestIndex        int `validate:"min=0"`
+	ExpectedRevision *int
+}
+
+func NewMoveTrackSignal(args NewMoveTrackSignalArgs) MoveTrackSignal {
+	return MoveTrackSignal{
+		Route:            SignalMoveTrack,
+		TrackID:          args.TrackID,
+		UserID:           args.UserID,
+		DeviceID:         args.DeviceID,
+		FromIndex:        args.FromIndex,
+		DestIndex:        args.DestIndex,
+		ExpectedRevision: args.ExpectedRevision,
 	}
 }
 
 type DeleteTracksSignal struct {
 	Route shared.SignalRoute `validate:"required"`
 
-	TracksIDs []string `validate:"required,dive,required"`
-	UserID    string   `validate:"required"`
-	DeviceID  string   `validate:"required"`
+	TracksIDs        []string `validate:"required,dive,required"`
+	UserID           string   `validate:"required"`
+	DeviceID         string   `validate:"required"`
+	ExpectedRevision *int     `validate:"omitempty,min=0"`
 }
 
 type NewDeleteTracksSignalArgs struct {
-	TracksIDs []string
-	UserID    string
-	DeviceID  string
+	TracksIDs        []string
+	UserID           string
+	DeviceID         string
+	ExpectedRevision *int
 }
 
 func NewDeleteTracksSignal(args NewDeleteTracksSignalArgs) DeleteTracksSignal {
 	return DeleteTracksSignal{
 		Route: SignalDeleteTracks,
 
-		TracksIDs: args.TracksIDs,
-		UserID:    args.UserID,
-		DeviceID:  args.DeviceID,
+		TracksIDs:        args.TracksIDs,
+		UserID:           args.UserID,
+		DeviceID:         args.DeviceID,
+		ExpectedRevision: args.ExpectedRevision,
+	}
+}
+
+type UndoSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	UserID   string `validate:"required"`
+	DeviceID string `validate:"required"`
+	// OnlyOwnOperations restricts the undo to the operations of the user
+	OnlyOwnOperations bool
+}
+
+type NewUndoSignalArgs struct {
+	UserID            string
+	DeviceID          string
+	OnlyOwnOperations bool
+}
+
+func NewUndoSignal(args NewUndoSignalArgs) UndoSignal {
+	return UndoSignal{
+		Route: SignalUndo,
+
+		UserID:            args.UserID,
+		DeviceID:          args.DeviceID,
+		OnlyOwnOperations: args.OnlyOwnOperations,
+	}
+}
+
+type RedoSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	UserID   string `validate:"required"`
+	DeviceID string `validate:"required"`
+	// OnlyOwnOperations restricts the redo to the operations of the user
+	OnlyOwnOperations bool
+}
+
+type NewRedoSignalArgs struct {
+	UserID            string
+	DeviceID          string
+	OnlyOwnOperations bool
+}
+
+func NewRedoSignal(args NewRedoSignalArgs) RedoSignal {
+	return RedoSignal{
+		Route: SignalRedo,
+
+		UserID:            args.UserID,
+		DeviceID:          args.DeviceID,
+		OnlyOwnOperations: args.OnlyOwnOperations,
 	}
 }
 
@@ -129,6 +230,114 @@ func NewRemoveUserSignal(args NewRemoveUserSignalArgs) RemoveUserSignal {
 	}
 }
 
+type KickUserSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	UserID       string `validate:"required"`
+	KickedUserID string `validate:"required"`
+}
+
+type NewKickUserSignalArgs struct {
+	UserID       string
+	KickedUserID string
+}
+
+func NewKickUserSignal(args NewKickUserSignalArgs) KickUserSignal {
+	return KickUserSignal{
+		Route: SignalKickUser,
+
+		UserID:       args.UserID,
+		KickedUserID: args.KickedUserID,
+	}
+}
+
+type BanUserSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	UserID       string `validate:"required"`
+	BannedUserID string `validate:"required"`
+}
+
+type NewBanUserSignalArgs struct {
+	UserID       string
+	BannedUserID string
+}
+
+func NewBanUserSignal(args NewBanUserSignalArgs) BanUserSignal {
+	return BanUserSignal{
+		Route: SignalBanUser,
+
+		UserID:       args.UserID,
+		BannedUserID: args.BannedUserID,
+	}
+}
+
+type InviteUserSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	UserID        string `validate:"required"`
+	InvitedUserID string `validate:"required"`
+}
+
+type NewInviteUserSignalArgs struct {
+	UserID        string
+	InvitedUserID string
+}
+
+func NewInviteUserSignal(args NewInviteUserSignalArgs) InviteUserSignal {
+	return InviteUserSignal{
+		Route: SignalInviteUser,
+
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
+
+type RevokeInvitationSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	UserID        string `validate:"required"`
+	InvitedUserID string `validate:"required"`
+}
+
+type NewRevokeInvitationSignalArgs struct {
+	UserID        string
+	InvitedUserID string
+}
+
+func NewRevokeInvitationSignal(args NewRevokeInvitationSignalArgs) RevokeInvitationSignal {
+	return RevokeInvitationSignal{
+		Route: SignalRevokeInvitation,
+
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
+
+type UpdateUserRoleSignal struct {
+	Route shared.SignalRoute `validate:"required"`
+
+	UserID        string          `validate:"required"`
+	UpdatedUserID string          `validate:"required"`
+	Role          MpeRoomUserRole `validate:"required"`
+}
+
+type NewUpdateUserRoleSignalArgs struct {
+	UserID        string
+	UpdatedUserID string
+	Role          MpeRoomUserRole
+}
+
+func NewUpdateUserRoleSignal(args NewUpdateUserRoleSignalArgs) UpdateUserRoleSignal {
+	return UpdateUserRoleSignal{
+		Route: SignalUpdateUserRole,
+
+		UserID:        args.UserID,
+		UpdatedUserID: args.UpdatedUserID,
+		Role:          args.Role,
+	}
+}
+
 type ExportToMtvRoomSignal struct {
 	Route shared.SignalRoute `validate:"required"`
 
@@ -9,6 +9,8 @@ import (
 	"github.com/AdonisEnProvence/MusicRoom/activities"
 	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
 	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/providers"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
 
 	"github.com/Devessier/brainy"
@@ -26,11 +28,22 @@ type MpeRoomInternalState struct {
 	Machine       *brainy.Machine
 	Users         map[string]*shared_mpe.InternalStateUser
 	Tracks        shared_mpe.TrackMetadataSet
+
+	bannedUsersIDs []string
+	invitations    []shared_mpe.MpeRoomInvitation
+	// revision is incremented each time the tracks list is edited
+	revision      int
+	operationsLog shared_mpe.PlaylistOperationsLog
+	auditLog      shared_mpe.PlaylistAuditLog
 }
 
 func (s *MpeRoomInternalState) AddUser(user shared_mpe.InternalStateUser) {
 	//Do not override user if already exist
 	if _, ok := s.Users[user.UserID]; !ok {
+		user.UserHasBeenInvited = user.UserHasBeenInvited || s.UserHasBeenInvited(user.UserID)
+		if !user.Role.IsValid() {
+			user.Role = s.getDefaultRole(user.UserHasBeenInvited)
+		}
 		s.Users[user.UserID] = &user
 	} else {
 		fmt.Printf("\n User %s already existing in s.Users\n", user.UserID)
@@ -46,6 +59,92 @@ func (s *MpeRoomInternalState) RemoveUser(userID string) bool {
 	return false
 }
 
+func (s *MpeRoomInternalState) BanUser(userID string) {
+	if s.UserIsBanned(userID) {
+		return
+	}
+
+	s.bannedUsersIDs = append(s.bannedUsersIDs, userID)
+}
+
+func (s *MpeRoomInternalState) UserIsBanned(userID string) bool {
+	for _, bannedUserID := range s.bannedUsersIDs {
+		if bannedUserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *MpeRoomInternalState) UserHasBeenInvited(userID string) bool {
+	for _, invitation := range s.invitations {
+		if invitation.InvitedUserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *MpeRoomInternalState) InviteUser(invitingUserID string, invitedUserID string) bool {
+	if s.UserHasBeenInvited(invitedUserID) {
+		return false
+	}
+
+	s.invitations = append(s.invitations, shared_mpe.MpeRoomInvitation{
+		InvitedUserID:  invitedUserID,
+		InvitingUserID: invitingUserID,
+	})
+
+	if user, ok := s.Users[invitedUserID]; ok {
+		user.UserHasBeenInvited = true
+
+		//Viewers granted by the invited users only edition rule are promoted
+		if !user.RoleIsExplicit && user.Role == shared_mpe.MpeRoomUserRoleViewer {
+			user.Role = s.getDefaultRole(true)
+		}
+	}
+
+	return true
+}
+
+func (s *MpeRoomInternalState) RevokeInvitation(invitedUserID string) bool {
+	for index, invitation := range s.invitations {
+		if invitation.InvitedUserID != invitedUserID {
+			continue
+		}
+
+		s.invitations = append(s.invitations[:index], s.invitations[index+1:]...)
+
+		if user, ok := s.Users[invitedUserID]; ok {
+			user.UserHasBeenInvited = false
+
+			if !user.RoleIsExplicit && !user.Role.CanManageMembers() {
+				user.Role = s.getDefaultRole(false)
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func (s *MpeRoomInternalState) PendingInvitations() []shared_mpe.MpeRoomInvitation {
+	pendingInvitations := make([]shared_mpe.MpeRoomInvitation, 0, len(s.invitations))
+
+	for _, invitation := range s.invitations {
+		if _, ok := s.Users[invitation.InvitedUserID]; ok {
+			continue
+		}
+
+		pendingInvitations = append(pendingInvitations, invitation)
+	}
+
+	return pendingInvitations
+}
+
 func (s *MpeRoomInternalState) GetUserRelatedInformation(userID string) *shared_mpe.InternalStateUser {
 	if userInformation, ok := s.Users[userID]; userID != shared_mpe.NoRelatedUserID && ok {
 		return userInformation
@@ -53,22 +152,82 @@ func (s *MpeRoomInternalState) GetUserRelatedInformation(userID string) *shared_
 	return nil
 }
 
+func (s *MpeRoomInternalState) ExportAuditLog(page int, limit int) shared_mpe.MpeRoomExposedAuditLog {
+	entries, hasMore := s.auditLog.Page(page, limit)
+
+	exposedEntries := make([]shared_mpe.ExposedPlaylistAuditLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		exposedEntries = append(exposedEntries, entry.Export())
+	}
+
+	return shared_mpe.MpeRoomExposedAuditLog{
+		Entries: exposedEntries,
+		Page:    page,
+		HasMore: hasMore,
+	}
+}
+
+func (s *MpeRoomInternalState) IncrementRevision() {
+	s.revision++
+}
+
+// RevisionIsOutdated returns true if the edition has been based on a previous revision of the tracks list,
+// editions that do not specify a revision are never considered as outdated.
+func (s *MpeRoomInternalState) RevisionIsOutdated(expectedRevision *int) bool {
+	if expectedRevision == nil {
+		return false
+	}
+
+	return *expectedRevision != s.revision
+}
+
 func (s *MpeRoomInternalState) getRoomIsOpenAndOnlyInvitedUsersCanEdit() bool {
 	return s.initialParams.IsOpen && s.initialParams.IsOpenOnlyInvitedUsersCanEdit
 }
 
+// getDefaultRole returns the role given to a joining user,
+// when only invited users can edit the others are only viewers.
+func (s *MpeRoomInternalState) getDefaultRole(userHasBeenInvited bool) shared_mpe.MpeRoomUserRole {
+	if s.getRoomIsOpenAndOnlyInvitedUsersCanEdit() && !userHasBeenInvited {
+		return shared_mpe.MpeRoomUserRoleViewer
+	}
+
+	return shared_mpe.MpeRoomUserRoleEditor
+}
+
+func (s *MpeRoomInternalState) UpdateUserRole(userID string, role shared_mpe.MpeRoomUserRole) bool {
+	user, ok := s.Users[userID]
+	if !ok {
+		return false
+	}
+
+	user.Role = role
+	user.RoleIsExplicit = true
+	return true
+}
+
 //This method will merge given params in the internalState
 func (s *MpeRoomInternalState) FillWith(params shared_mpe.MpeRoomParameters) {
 	s.initialParams = params
 	s.Tracks.Init()
 	s.Users = make(map[string]*shared_mpe.InternalStateUser)
-	s.AddUser(*params.CreatorUserRelatedInformation)
+	s.bannedUsersIDs = make([]string, 0)
+	s.invitations = make([]shared_mpe.MpeRoomInvitation, 0)
+
+	creator := *params.CreatorUserRelatedInformation
+	creator.Role = shared_mpe.MpeRoomUserRoleAdmin
+	s.AddUser(creator)
 }
 
 // In the internalState.Export method we do not use workflow.sideEffect for at least two reasons:
 // 1- we cannot use workflow.sideEffect in the getState queryHandler
 // 2- we never update our internalState depending on internalState.Export() results this data aims to be sent to adonis.
 func (s *MpeRoomInternalState) Export(userID string) shared_mpe.MpeRoomExposedState {
+	tracks := s.Tracks.Values()
+	exposedTracks := make([]shared_mpe.ExposedPlaylistTrack, 0, len(tracks))
+	for _, track := range tracks {
+		exposedTracks = append(exposedTracks, track.Export())
+	}
 
 	exposedState := shared_mpe.MpeRoomExposedState{
 		UsersLength:                   len(s.Users),
@@ -78,8 +237,9 @@ func (s *MpeRoomInternalState) Export(userID string) shared_mpe.MpeRoomExposedSt
 		IsOpen:                        s.initialParams.IsOpen,
 		IsOpenOnlyInvitedUsersCanEdit: s.initialParams.IsOpenOnlyInvitedUsersCanEdit,
 		UserRelatedInformation:        s.GetUserRelatedInformation(userID),
-		Tracks:                        s.Tracks.Values(),
+		Tracks:                        exposedTracks,
 		PlaylistTotalDuration:         s.Tracks.GetTotalTracksDuration(),
+		Revision:                      s.revision,
 	}
 
 	return exposedState
@@ -93,9 +253,16 @@ const (
 	MpeRoomAddTracksEventType                     brainy.EventType = "ADD_TRACKS"
 	MpeRoomAddedTracksInformationFetchedEventType brainy.EventType = "ADDED_TRACKS_INFORMATION_FETCHED"
 	MpeRoomChangeTrackOrderEventType              brainy.EventType = "CHANGE_TRACK_ORDER"
+	MpeRoomMoveTrackEventType                     brainy.EventType = "MOVE_TRACK"
 	MpeRoomDeleteTracksEventType                  brainy.EventType = "DELETE_TRACKS"
+	MpeRoomUndoEventType                          brainy.EventType = "UNDO"
+	MpeRoomRedoEventType                          brainy.EventType = "REDO"
 	MpeRoomAddUserEventType                       brainy.EventType = "ADD_USER"
 	MpeRoomRemoveUserEventType                    brainy.EventType = "REMOVE_USER"
+	MpeRoomKickUserEventType                      brainy.EventType = "KICK_USER"
+	MpeRoomInviteUserEventType                    brainy.EventType = "INVITE_USER"
+	MpeRoomRevokeInvitationEventType              brainy.EventType = "REVOKE_INVITATION"
+	MpeRoomUpdateUserRoleEventType                brainy.EventType = "UPDATE_USER_ROLE"
 	MpeExportToMtvRoomEventType                   brainy.EventType = "EXPORT_TO_MTV_ROOM"
 )
 
@@ -145,6 +312,30 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 		return err
 	}
 
+	if err := workflow.SetQueryHandler(
+		ctx,
+		shared_mpe.MpeGetPendingInvitationsQuery,
+		func() ([]shared_mpe.MpeRoomInvitation, error) {
+
+			return internalState.PendingInvitations(), nil
+		},
+	); err != nil {
+		logger.Info("SetQueryHandler for getPendingInvitations failed.", "Error", err)
+		return err
+	}
+
+	if err := workflow.SetQueryHandler(
+		ctx,
+		shared_mpe.MpeGetAuditLogQuery,
+		func(page int, limit int) (shared_mpe.MpeRoomExposedAuditLog, error) {
+
+			return internalState.ExportAuditLog(page, limit), nil
+		},
+	); err != nil {
+		logger.Info("SetQueryHandler for getAuditLog failed.", "Error", err)
+		return err
+	}
+
 	channel := workflow.GetSignalChannel(ctx, shared_mpe.SignalChannelName)
 
 	var (
@@ -178,7 +369,7 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 
 						Actions: brainy.Actions{
 							brainy.ActionFn(
-								assignInitialFetchedTracks(&internalState),
+								assignInitialFetchedTracks(ctx, &internalState),
 							),
 							brainy.ActionFn(
 								func(c brainy.Context, e brainy.Event) error {
@@ -190,6 +381,9 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 									return nil
 								},
 							),
+							brainy.ActionFn(
+								reportUnresolvedInitialTracks(ctx, &internalState),
+							),
 						},
 					},
 				},
@@ -199,6 +393,15 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 				On: brainy.Events{
 
 					MpeRoomAddTracksEventType: brainy.Transitions{
+						{
+							Cond: editionIsBasedOnOutdatedRevision(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									rejectConflictingEdition(ctx, &internalState),
+								),
+							},
+						},
 						{
 							Cond: userCanPerformAddTrackOperation(&internalState),
 
@@ -208,13 +411,16 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 										event := e.(MpeRoomAddTracksEvent)
 
 										acceptedTracksIDsToAdd := make([]string, 0, len(event.TracksIDs))
+										isAcceptedTrackToAdd := make(map[string]bool, len(event.TracksIDs))
 
+										//Tracks ids are canonical, the same track can not be added twice
 										for _, trackToAdd := range event.TracksIDs {
-											isDuplicate := internalState.Tracks.Has(trackToAdd)
+											isDuplicate := internalState.Tracks.Has(trackToAdd) || isAcceptedTrackToAdd[trackToAdd]
 											if isDuplicate {
 												continue
 											}
 
+											isAcceptedTrackToAdd[trackToAdd] = true
 											acceptedTracksIDsToAdd = append(acceptedTracksIDsToAdd, trackToAdd)
 										}
 
@@ -260,23 +466,84 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 						},
 					},
 
-					MpeRoomAddedTracksInformationFetchedEventType: brainy.Transition{
-						Actions: brainy.Actions{
-							brainy.ActionFn(
-								func(c brainy.Context, e brainy.Event) error {
-									event := e.(MpeRoomAddedTracksInformationFetchedEvent)
+					MpeRoomAddedTracksInformationFetchedEventType: brainy.Transitions{
+						{
+							Cond: userCanAddFetchedTracks(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									func(c brainy.Context, e brainy.Event) error {
+										event := e.(MpeRoomAddedTracksInformationFetchedEvent)
 
-									// If all tracks to add are already in the playlist, abort the operation.
-									allTracksAreDuplicated := true
-									for _, track := range event.AddedTracksInformation {
-										if trackIsNotDuplicated := !internalState.Tracks.Has(track.ID); trackIsNotDuplicated {
-											allTracksAreDuplicated = false
+										// If all tracks to add are already in the playlist, abort the operation.
+										allTracksAreDuplicated := true
+										for _, track := range event.AddedTracksInformation {
+											if trackIsNotDuplicated := !internalState.Tracks.Has(track.ID); trackIsNotDuplicated {
+												allTracksAreDuplicated = false
 
-											break
+												break
+											}
 										}
-									}
 
-									if allTracksAreDuplicated {
+										if allTracksAreDuplicated {
+											rejectAddingTracksArgs := activities_mpe.RejectAddingTracksActivityArgs{
+												RoomID:   params.RoomID,
+												UserID:   event.UserID,
+												DeviceID: event.DeviceID,
+											}
+											if len(event.RejectedTracks) > 0 {
+												rejectAddingTracksArgs.RejectedTracks = event.RejectedTracks
+											}
+											sendRejectAddingTracksActivity(ctx, rejectAddingTracksArgs)
+
+											return nil
+										}
+
+										now := getNowFromSideEffect(ctx)
+										addedTracks := make([]shared_mpe.PositionedTrack, 0, len(event.AddedTracksInformation))
+										for _, trackInformation := range event.AddedTracksInformation {
+											track := shared_mpe.PlaylistTrack{
+												TrackMetadata: trackInformation,
+
+												AddedByUserID: event.UserID,
+												AddedAt:       now,
+											}
+											if err := internalState.Tracks.Add(track); err != nil {
+												continue
+											}
+
+											addedTracks = append(addedTracks, shared_mpe.PositionedTrack{
+												Track: track,
+												Index: internalState.Tracks.IndexOf(track.ID),
+											})
+										}
+										internalState.IncrementRevision()
+										operation := shared_mpe.PlaylistOperation{
+											Type:   shared_mpe.PlaylistOperationAddTracks,
+											UserID: event.UserID,
+											Tracks: addedTracks,
+										}
+										internalState.operationsLog.Push(operation)
+										appendToAuditLog(ctx, &internalState, operation, event.DeviceID, shared_mpe.PlaylistAuditLogSourceEdition)
+
+										sendAcknowledgeAddingTracksActivity(ctx, activities_mpe.AcknowledgeAddingTracksActivityArgs{
+											State:          internalState.Export(shared_mpe.NoRelatedUserID),
+											UserID:         event.UserID,
+											DeviceID:       event.DeviceID,
+											RejectedTracks: event.RejectedTracks,
+										})
+
+										return nil
+									},
+								),
+							},
+						},
+						{
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									func(c brainy.Context, e brainy.Event) error {
+										event := e.(MpeRoomAddedTracksInformationFetchedEvent)
+
 										sendRejectAddingTracksActivity(ctx, activities_mpe.RejectAddingTracksActivityArgs{
 											RoomID:   params.RoomID,
 											UserID:   event.UserID,
@@ -284,25 +551,22 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 										})
 
 										return nil
-									}
-
-									for _, track := range event.AddedTracksInformation {
-										internalState.Tracks.Add(track)
-									}
-
-									sendAcknowledgeAddingTracksActivity(ctx, activities_mpe.AcknowledgeAddingTracksActivityArgs{
-										State:    internalState.Export(shared_mpe.NoRelatedUserID),
-										UserID:   event.UserID,
-										DeviceID: event.DeviceID,
-									})
-
-									return nil
-								},
-							),
+									},
+								),
+							},
 						},
 					},
 
 					MpeRoomChangeTrackOrderEventType: brainy.Transitions{
+						{
+							Cond: editionIsBasedOnOutdatedRevision(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									rejectConflictingEdition(ctx, &internalState),
+								),
+							},
+						},
 						{
 							Cond: userCanPerformChangeTrackOrderPlaylistEditionOperation(&internalState),
 
@@ -330,24 +594,174 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 						},
 					},
 
-					MpeRoomAddUserEventType: brainy.Transition{
-						Cond: userIsNotAlreadyInRoom(&internalState),
+					MpeRoomMoveTrackEventType: brainy.Transitions{
+						{
+							Cond: editionIsBasedOnOutdatedRevision(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									rejectConflictingEdition(ctx, &internalState),
+								),
+							},
+						},
+						{
+							Cond: userCanPerformMoveTrackPlaylistEditionOperation(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									moveTrack(ctx, &internalState),
+								),
+							},
+						},
+						{
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									func(c brainy.Context, e brainy.Event) error {
+										fmt.Println("userCanPerformMoveTrackPlaylistEditionOperation is false")
+										event := e.(MpeRoomMoveTrackEvent)
+
+										sendRejectChangeTrackOrderActivity(ctx, activities_mpe.RejectChangeTrackOrderActivityArgs{
+											DeviceID: event.DeviceID,
+											UserID:   event.UserID,
+											RoomID:   internalState.initialParams.RoomID,
+										})
+										return nil
+									}),
+							},
+						},
+					},
+
+					MpeRoomAddUserEventType: brainy.Transitions{
+						{
+							Cond: userIsNotAllowedToJoinRoom(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									func(c brainy.Context, e brainy.Event) error {
+										event := e.(MpeRoomAddUserEvent)
+
+										sendRejectJoinActivity(ctx, activities_mpe.RejectJoinActivityArgs{
+											RoomID: internalState.initialParams.RoomID,
+											UserID: event.UserID,
+										})
+
+										return nil
+									},
+								),
+							},
+						},
+
+						{
+							Cond: userIsNotAlreadyInRoom(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									func(c brainy.Context, e brainy.Event) error {
+										event := e.(MpeRoomAddUserEvent)
+
+										user := shared_mpe.InternalStateUser{
+											UserHasBeenInvited: event.UserHasBeenInvited,
+											UserID:             event.UserID,
+										}
+										internalState.AddUser(user)
+
+										sendAcknowledgeJoinActivity(ctx, activities_mpe.AcknowledgeJoinActivityArgs{
+											State:         internalState.Export(event.UserID),
+											JoiningUserID: event.UserID,
+										})
+										return nil
+									},
+								),
+							},
+						},
+					},
+
+					MpeRoomInviteUserEventType: brainy.Transition{
+						Cond: userCanInviteUser(&internalState),
+
+						Actions: brainy.Actions{
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									event := e.(MpeRoomInviteUserEvent)
+
+									internalState.InviteUser(event.UserID, event.InvitedUserID)
+
+									sendAcknowledgeInviteUserActivity(ctx, activities_mpe.AcknowledgeInviteUserActivityArgs{
+										State:          internalState.Export(shared_mpe.NoRelatedUserID),
+										InvitingUserID: event.UserID,
+										InvitedUserID:  event.InvitedUserID,
+									})
+
+									return nil
+								},
+							),
+						},
+					},
+
+					MpeRoomRevokeInvitationEventType: brainy.Transition{
+						Cond: userCanRevokeInvitation(&internalState),
+
+						Actions: brainy.Actions{
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									event := e.(MpeRoomRevokeInvitationEvent)
+
+									internalState.RevokeInvitation(event.InvitedUserID)
+
+									sendAcknowledgeRevokeInvitationActivity(ctx, activities_mpe.AcknowledgeRevokeInvitationActivityArgs{
+										State:         internalState.Export(shared_mpe.NoRelatedUserID),
+										InvitedUserID: event.InvitedUserID,
+									})
+
+									return nil
+								},
+							),
+						},
+					},
+
+					MpeRoomUpdateUserRoleEventType: brainy.Transition{
+						Cond: userCanUpdateUserRole(&internalState),
 
 						Actions: brainy.Actions{
 							brainy.ActionFn(
 								func(c brainy.Context, e brainy.Event) error {
-									event := e.(MpeRoomAddUserEvent)
+									event := e.(MpeRoomUpdateUserRoleEvent)
 
-									user := shared_mpe.InternalStateUser{
-										UserHasBeenInvited: event.UserHasBeenInvited,
-										UserID:             event.UserID,
+									if success := internalState.UpdateUserRole(event.UpdatedUserID, event.Role); success {
+										sendAcknowledgeUpdateUserRoleActivity(ctx, activities_mpe.AcknowledgeUpdateUserRoleActivityArgs{
+											State:         internalState.Export(event.UpdatedUserID),
+											UpdatedUserID: event.UpdatedUserID,
+										})
 									}
-									internalState.AddUser(user)
 
-									sendAcknowledgeJoinActivity(ctx, activities_mpe.AcknowledgeJoinActivityArgs{
-										State:         internalState.Export(event.UserID),
-										JoiningUserID: event.UserID,
+									return nil
+								},
+							),
+						},
+					},
+
+					MpeRoomKickUserEventType: brainy.Transition{
+						Cond: userCanKickUser(&internalState),
+
+						Actions: brainy.Actions{
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									event := e.(MpeRoomKickUserEvent)
+
+									if event.Ban {
+										internalState.BanUser(event.KickedUserID)
+										internalState.RevokeInvitation(event.KickedUserID)
+									}
+
+									//A banned user who is not in the room is acknowledged as well
+									internalState.RemoveUser(event.KickedUserID)
+
+									sendAcknowledgeKickUserActivity(ctx, activities_mpe.AcknowledgeKickUserActivityArgs{
+										State:        internalState.Export(shared_mpe.NoRelatedUserID),
+										KickedUserID: event.KickedUserID,
+										Banned:       event.Ban,
 									})
+
 									return nil
 								},
 							),
@@ -374,6 +788,15 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 					},
 
 					MpeRoomDeleteTracksEventType: brainy.Transitions{
+						{
+							Cond: editionIsBasedOnOutdatedRevision(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									rejectConflictingEdition(ctx, &internalState),
+								),
+							},
+						},
 						{
 							Cond: userCanPerformDeleteTracksOperation(&internalState),
 
@@ -382,8 +805,33 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 									func(c brainy.Context, e brainy.Event) error {
 										event := e.(MpeRoomDeleteTracksEvent)
 
-										for _, trackID := range event.TracksIDs {
-											internalState.Tracks.Delete(trackID)
+										//Positions are saved before deleting anything so that the tracks can be restored on undo
+										deletedTracks := make([]shared_mpe.PositionedTrack, 0, len(event.TracksIDs))
+										for index, track := range internalState.Tracks.Values() {
+											for _, trackID := range event.TracksIDs {
+												if track.ID == trackID {
+													deletedTracks = append(deletedTracks, shared_mpe.PositionedTrack{
+														Track: track,
+														Index: index,
+													})
+
+													break
+												}
+											}
+										}
+
+										for _, deletedTrack := range deletedTracks {
+											internalState.Tracks.Delete(deletedTrack.Track.ID)
+										}
+										if tracksListHasChanged := len(deletedTracks) > 0; tracksListHasChanged {
+											internalState.IncrementRevision()
+											operation := shared_mpe.PlaylistOperation{
+												Type:   shared_mpe.PlaylistOperationDeleteTracks,
+												UserID: event.UserID,
+												Tracks: deletedTracks,
+											}
+											internalState.operationsLog.Push(operation)
+											appendToAuditLog(ctx, &internalState, operation, event.DeviceID, shared_mpe.PlaylistAuditLogSourceEdition)
 										}
 
 										sendAcknowledgeDeletingTracksActivity(ctx, activities_mpe.AcknowledgeDeletingTracksActivityArgs{
@@ -399,6 +847,62 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 						},
 					},
 
+					MpeRoomUndoEventType: brainy.Transitions{
+						{
+							Cond: userCanUndoPlaylistOperation(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									undoPlaylistOperation(ctx, &internalState),
+								),
+							},
+						},
+						{
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									func(c brainy.Context, e brainy.Event) error {
+										fmt.Println("userCanUndoPlaylistOperation is false")
+										event := e.(MpeRoomUndoEvent)
+
+										sendRejectUndoRedoActivity(ctx, activities_mpe.RejectUndoRedoActivityArgs{
+											RoomID:   internalState.initialParams.RoomID,
+											UserID:   event.UserID,
+											DeviceID: event.DeviceID,
+										})
+										return nil
+									}),
+							},
+						},
+					},
+
+					MpeRoomRedoEventType: brainy.Transitions{
+						{
+							Cond: userCanRedoPlaylistOperation(&internalState),
+
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									redoPlaylistOperation(ctx, &internalState),
+								),
+							},
+						},
+						{
+							Actions: brainy.Actions{
+								brainy.ActionFn(
+									func(c brainy.Context, e brainy.Event) error {
+										fmt.Println("userCanRedoPlaylistOperation is false")
+										event := e.(MpeRoomRedoEvent)
+
+										sendRejectUndoRedoActivity(ctx, activities_mpe.RejectUndoRedoActivityArgs{
+											RoomID:   internalState.initialParams.RoomID,
+											UserID:   event.UserID,
+											DeviceID: event.DeviceID,
+										})
+										return nil
+									}),
+							},
+						},
+					},
+
 					MpeExportToMtvRoomEventType: brainy.Transition{
 						Cond: userCanExportToMtv(&internalState),
 
@@ -414,11 +918,20 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 										tracksIDs = append(tracksIDs, trackMetadata.ID)
 									}
 
+									mtvRoomOptions := event.MtvRoomOptions
+									//The mtv room autofills from the exported playlist tracks
+									if autofill := mtvRoomOptions.Autofill; autofill != nil && autofill.Source == shared_mtv.MtvAutofillSourcePlaylist {
+										mtvRoomOptions.Autofill = &shared_mtv.MtvRoomAutofill{
+											Source:        autofill.Source,
+											SeedTracksIDs: tracksIDs,
+										}
+									}
+
 									sendMtvRoomCreationRequestToServerActivity(ctx, activities_mpe.SendMtvRoomCreationRequestToServerActivityArgs{
 										UserID:         event.UserID,
 										DeviceID:       event.DeviceID,
 										TracksIDs:      tracksIDs,
-										MtvRoomOptions: event.MtvRoomOptions,
+										MtvRoomOptions: mtvRoomOptions,
 									})
 
 									return nil
@@ -467,9 +980,10 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 
 				internalState.Machine.Send(
 					NewMpeRoomAddTracksEvent(NewMpeRoomAddTracksEventArgs{
-						TracksIDs: message.TracksIDs,
-						UserID:    message.UserID,
-						DeviceID:  message.DeviceID,
+						TracksIDs:        providers.CanonicalTracksIDs(message.TracksIDs),
+						UserID:           message.UserID,
+						DeviceID:         message.DeviceID,
+						ExpectedRevision: message.ExpectedRevision,
 					}),
 				)
 
@@ -494,11 +1008,35 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 
 				internalState.Machine.Send(
 					NewMpeRoomChangeTrackOrderEvent(NewMpeRoomChangeTrackOrderEventArgs{
-						TrackID:          message.TrackID,
+						TrackID:          providers.CanonicalTrackID(message.TrackID),
 						UserID:           message.UserID,
 						DeviceID:         message.DeviceID,
 						OperationToApply: message.OperationToApply,
 						FromIndex:        message.FromIndex,
+						ExpectedRevision: message.ExpectedRevision,
+					}),
+				)
+
+			case shared_mpe.SignalMoveTrack:
+				var message shared_mpe.MoveTrackSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomMoveTrackEvent(NewMpeRoomMoveTrackEventArgs{
+						TrackID:          providers.CanonicalTrackID(message.TrackID),
+						UserID:           message.UserID,
+						DeviceID:         message.DeviceID,
+						FromIndex:        message.FromIndex,
+						DestIndex:        message.DestIndex,
+						ExpectedRevision: message.ExpectedRevision,
 					}),
 				)
 
@@ -516,9 +1054,50 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 
 				internalState.Machine.Send(
 					NewMpeRoomDeleteTracksEvent(NewMpeRoomDeleteTracksEventArgs{
-						TracksIDs: message.TracksIDs,
-						UserID:    message.UserID,
-						DeviceID:  message.DeviceID,
+						TracksIDs:        providers.CanonicalTracksIDs(message.TracksIDs),
+						UserID:           message.UserID,
+						DeviceID:         message.DeviceID,
+						ExpectedRevision: message.ExpectedRevision,
+					}),
+				)
+
+			case shared_mpe.SignalUndo:
+				var message shared_mpe.UndoSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomUndoEvent(NewMpeRoomUndoEventArgs{
+						UserID:            message.UserID,
+						DeviceID:          message.DeviceID,
+						OnlyOwnOperations: message.OnlyOwnOperations,
+					}),
+				)
+
+			case shared_mpe.SignalRedo:
+				var message shared_mpe.RedoSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomRedoEvent(NewMpeRoomRedoEventArgs{
+						UserID:            message.UserID,
+						DeviceID:          message.DeviceID,
+						OnlyOwnOperations: message.OnlyOwnOperations,
 					}),
 				)
 
@@ -559,6 +1138,104 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 					}),
 				)
 
+			case shared_mpe.SignalKickUser:
+				var message shared_mpe.KickUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomKickUserEvent(NewMpeRoomKickUserEventArgs{
+						UserID:       message.UserID,
+						KickedUserID: message.KickedUserID,
+						Ban:          false,
+					}),
+				)
+
+			case shared_mpe.SignalBanUser:
+				var message shared_mpe.BanUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomKickUserEvent(NewMpeRoomKickUserEventArgs{
+						UserID:       message.UserID,
+						KickedUserID: message.BannedUserID,
+						Ban:          true,
+					}),
+				)
+
+			case shared_mpe.SignalInviteUser:
+				var message shared_mpe.InviteUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomInviteUserEvent(NewMpeRoomInviteUserEventArgs{
+						UserID:        message.UserID,
+						InvitedUserID: message.InvitedUserID,
+					}),
+				)
+
+			case shared_mpe.SignalRevokeInvitation:
+				var message shared_mpe.RevokeInvitationSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomRevokeInvitationEvent(NewMpeRoomRevokeInvitationEventArgs{
+						UserID:        message.UserID,
+						InvitedUserID: message.InvitedUserID,
+					}),
+				)
+
+			case shared_mpe.SignalUpdateUserRole:
+				var message shared_mpe.UpdateUserRoleSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMpeRoomUpdateUserRoleEvent(NewMpeRoomUpdateUserRoleEventArgs{
+						UserID:        message.UserID,
+						UpdatedUserID: message.UpdatedUserID,
+						Role:          message.Role,
+					}),
+				)
+
 			case shared_mpe.SignalExportToMtvRoom:
 				var message shared_mpe.ExportToMtvRoomSignal
 
@@ -591,7 +1268,7 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 			selector.AddFuture(fetchedInitialTracksFuture, func(f workflow.Future) {
 				fetchedInitialTracksFuture = nil
 
-				var initialTrackActivityResult []shared.TrackMetadata
+				var initialTrackActivityResult activities.FetchedTracksInformation
 
 				if err := f.Get(ctx, &initialTrackActivityResult); err != nil {
 					logger.Error("error occured initialTrackActivityResult", err)
@@ -603,7 +1280,10 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 				fmt.Printf("\n%+v\n", initialTrackActivityResult)
 				fmt.Println("**********************************")
 				internalState.Machine.Send(
-					NewMpeRoomInitialTracksFetchedEvent(initialTrackActivityResult),
+					NewMpeRoomInitialTracksFetchedEvent(
+						initialTrackActivityResult.Metadata,
+						initialTrackActivityResult.Statuses,
+					),
 				)
 			})
 		}
@@ -623,6 +1303,7 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 				internalState.Machine.Send(
 					NewMpeRoomAddedTracksInformationFetchedEvent(NewMpeRoomAddedTracksInformationFetchedEventArgs{
 						AddedTracksInformation: addedTracksInformationActivityResult.Metadata,
+						RejectedTracks:         shared_mpe.RejectedTracksFromFetchResults(addedTracksInformationActivityResult.Statuses),
 						UserID:                 addedTracksInformationActivityResult.UserID,
 						DeviceID:               addedTracksInformationActivityResult.DeviceID,
 					}),
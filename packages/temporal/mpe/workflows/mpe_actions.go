@@ -5,19 +5,55 @@ import (
 
 	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
 	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/providers"
 	"github.com/Devessier/brainy"
 	"go.temporal.io/sdk/workflow"
 )
 
-func assignInitialFetchedTracks(internalState *MpeRoomInternalState) brainy.Action {
+func assignInitialFetchedTracks(ctx workflow.Context, internalState *MpeRoomInternalState) brainy.Action {
 	return func(c brainy.Context, e brainy.Event) error {
 		event := e.(MpeRoomInitialTrackFetchedEvent)
+		now := getNowFromSideEffect(ctx)
 
 		internalState.Tracks.Clear()
 		for _, fetchedTrack := range event.Tracks {
-			internalState.Tracks.Add(fetchedTrack)
+			//Initial tracks are contributed by the creator
+			internalState.Tracks.Add(shared_mpe.PlaylistTrack{
+				TrackMetadata: fetchedTrack,
+
+				AddedByUserID: internalState.initialParams.RoomCreatorUserID,
+				AddedAt:       now,
+			})
+		}
+
+		return nil
+	}
+}
+
+//Initial tracks can be imported from a playlist file, some of them
+//may not exist on YouTube anymore or may be private
+func reportUnresolvedInitialTracks(ctx workflow.Context, internalState *MpeRoomInternalState) brainy.Action {
+	return func(c brainy.Context, e brainy.Event) error {
+		event := e.(MpeRoomInitialTrackFetchedEvent)
+
+		unresolvedTracksIDs := make([]string, 0)
+		for _, trackID := range internalState.initialParams.InitialTracksIDs {
+			if !internalState.Tracks.Has(providers.CanonicalTrackID(trackID)) {
+				unresolvedTracksIDs = append(unresolvedTracksIDs, trackID)
+			}
 		}
 
+		if len(unresolvedTracksIDs) == 0 {
+			return nil
+		}
+
+		sendReportUnresolvedInitialTracksActivity(ctx, activities_mpe.ReportUnresolvedInitialTracksActivityArgs{
+			RoomID:              internalState.initialParams.RoomID,
+			UserID:              internalState.initialParams.RoomCreatorUserID,
+			UnresolvedTracksIDs: unresolvedTracksIDs,
+			RejectedTracks:      shared_mpe.RejectedTracksFromFetchResults(event.Statuses),
+		})
+
 		return nil
 	}
 }
@@ -27,11 +63,13 @@ func changeTrackOrder(ctx workflow.Context, internalState *MpeRoomInternalState)
 	return func(c brainy.Context, e brainy.Event) error {
 		event := e.(MpeRoomChangeTrackOrderEvent)
 
+		var destIndex int
 		switch event.OperationToApply {
 		case shared_mpe.MpeOperationToApplyUp:
 
 			fmt.Println("UP")
-			if err := internalState.Tracks.Swap(event.FromIndex, event.FromIndex-1); err != nil {
+			destIndex = event.FromIndex - 1
+			if err := internalState.Tracks.Swap(event.FromIndex, destIndex); err != nil {
 
 				sendRejectChangeTrackOrderActivity(ctx, activities_mpe.RejectChangeTrackOrderActivityArgs{
 					DeviceID: event.DeviceID,
@@ -44,7 +82,8 @@ func changeTrackOrder(ctx workflow.Context, internalState *MpeRoomInternalState)
 		case shared_mpe.MpeOperationToApplyDown:
 
 			fmt.Println("DOWN")
-			if err := internalState.Tracks.Swap(event.FromIndex, event.FromIndex+1); err != nil {
+			destIndex = event.FromIndex + 1
+			if err := internalState.Tracks.Swap(event.FromIndex, destIndex); err != nil {
 
 				sendRejectChangeTrackOrderActivity(ctx, activities_mpe.RejectChangeTrackOrderActivityArgs{
 					DeviceID: event.DeviceID,
@@ -61,6 +100,52 @@ func changeTrackOrder(ctx workflow.Context, internalState *MpeRoomInternalState)
 			//he wrote the raw req
 			return nil
 		}
+		internalState.IncrementRevision()
+		//Swapping two adjacent tracks is the same as moving one of them
+		operation := shared_mpe.PlaylistOperation{
+			Type:      shared_mpe.PlaylistOperationMoveTrack,
+			UserID:    event.UserID,
+			TrackID:   event.TrackID,
+			FromIndex: event.FromIndex,
+			DestIndex: destIndex,
+		}
+		internalState.operationsLog.Push(operation)
+		appendToAuditLog(ctx, internalState, operation, event.DeviceID, shared_mpe.PlaylistAuditLogSourceEdition)
+
+		sendAcknowledgeChangeTrackOrderActivity(ctx, activities_mpe.AcknowledgeChangeTrackOrderActivityArgs{
+			DeviceID: event.DeviceID,
+			UserID:   event.UserID,
+			State:    internalState.Export(shared_mpe.NoRelatedUserID),
+		})
+
+		return nil
+	}
+}
+
+//This actions should be called only after calling userCanPerformMoveTrackPlaylistEditionOperation
+func moveTrack(ctx workflow.Context, internalState *MpeRoomInternalState) brainy.Action {
+	return func(c brainy.Context, e brainy.Event) error {
+		event := e.(MpeRoomMoveTrackEvent)
+
+		if err := internalState.Tracks.Move(event.FromIndex, event.DestIndex); err != nil {
+			sendRejectChangeTrackOrderActivity(ctx, activities_mpe.RejectChangeTrackOrderActivityArgs{
+				DeviceID: event.DeviceID,
+				UserID:   event.UserID,
+				RoomID:   internalState.initialParams.RoomID,
+			})
+			fmt.Println("MOVE FAILED", err)
+			return nil
+		}
+		internalState.IncrementRevision()
+		operation := shared_mpe.PlaylistOperation{
+			Type:      shared_mpe.PlaylistOperationMoveTrack,
+			UserID:    event.UserID,
+			TrackID:   event.TrackID,
+			FromIndex: event.FromIndex,
+			DestIndex: event.DestIndex,
+		}
+		internalState.operationsLog.Push(operation)
+		appendToAuditLog(ctx, internalState, operation, event.DeviceID, shared_mpe.PlaylistAuditLogSourceEdition)
 
 		sendAcknowledgeChangeTrackOrderActivity(ctx, activities_mpe.AcknowledgeChangeTrackOrderActivityArgs{
 			DeviceID: event.DeviceID,
@@ -71,3 +156,111 @@ func changeTrackOrder(ctx workflow.Context, internalState *MpeRoomInternalState)
 		return nil
 	}
 }
+
+//This action should be called only after calling editionIsBasedOnOutdatedRevision
+func rejectConflictingEdition(ctx workflow.Context, internalState *MpeRoomInternalState) brainy.Action {
+	return func(c brainy.Context, e brainy.Event) error {
+		userID, deviceID, expectedRevision := getEditionEventInitiatorAndExpectedRevision(e)
+		if expectedRevision == nil {
+			return nil
+		}
+
+		sendRejectConflictingEditionActivity(ctx, activities_mpe.RejectConflictingEditionActivityArgs{
+			State:            internalState.Export(userID),
+			UserID:           userID,
+			DeviceID:         deviceID,
+			ExpectedRevision: *expectedRevision,
+		})
+
+		return nil
+	}
+}
+
+//The operation UserID is the user who applied it on the tracks list
+func appendToAuditLog(ctx workflow.Context, internalState *MpeRoomInternalState, operation shared_mpe.PlaylistOperation, deviceID string, source shared_mpe.PlaylistAuditLogSource) {
+	internalState.auditLog.Add(shared_mpe.NewPlaylistAuditLogEntry(shared_mpe.NewPlaylistAuditLogEntryArgs{
+		OccurredAt: getNowFromSideEffect(ctx),
+		UserID:     operation.UserID,
+		DeviceID:   deviceID,
+		Operation:  operation,
+		Source:     source,
+	}))
+}
+
+//Sends back the acknowledgement activity of the regular edition matching the applied operation
+func acknowledgePlaylistOperation(ctx workflow.Context, internalState *MpeRoomInternalState, operation shared_mpe.PlaylistOperation, userID string, deviceID string) {
+	state := internalState.Export(shared_mpe.NoRelatedUserID)
+
+	switch operation.Type {
+	case shared_mpe.PlaylistOperationAddTracks:
+		sendAcknowledgeAddingTracksActivity(ctx, activities_mpe.AcknowledgeAddingTracksActivityArgs{
+			State:          state,
+			UserID:         userID,
+			DeviceID:       deviceID,
+			RejectedTracks: make([]shared_mpe.RejectedTrack, 0),
+		})
+	case shared_mpe.PlaylistOperationDeleteTracks:
+		sendAcknowledgeDeletingTracksActivity(ctx, activities_mpe.AcknowledgeDeletingTracksActivityArgs{
+			State:    state,
+			UserID:   userID,
+			DeviceID: deviceID,
+		})
+	case shared_mpe.PlaylistOperationMoveTrack:
+		sendAcknowledgeChangeTrackOrderActivity(ctx, activities_mpe.AcknowledgeChangeTrackOrderActivityArgs{
+			State:    state,
+			UserID:   userID,
+			DeviceID: deviceID,
+		})
+	}
+}
+
+//This action should be called only after calling userCanUndoPlaylistOperation
+func undoPlaylistOperation(ctx workflow.Context, internalState *MpeRoomInternalState) brainy.Action {
+	return func(c brainy.Context, e brainy.Event) error {
+		event := e.(MpeRoomUndoEvent)
+
+		operation, ok := internalState.operationsLog.Undo(event.UserID, event.OnlyOwnOperations)
+		if !ok {
+			return nil
+		}
+
+		inverse := operation.Inverse()
+		if err := inverse.ApplyTo(&internalState.Tracks); err != nil {
+			fmt.Println("UNDO FAILED", err)
+			return nil
+		}
+		internalState.IncrementRevision()
+		//The operation is reverted by the requesting user, who may not be its author
+		inverse.UserID = event.UserID
+		appendToAuditLog(ctx, internalState, inverse, event.DeviceID, shared_mpe.PlaylistAuditLogSourceUndo)
+
+		acknowledgePlaylistOperation(ctx, internalState, inverse, event.UserID, event.DeviceID)
+
+		return nil
+	}
+}
+
+//This action should be called only after calling userCanRedoPlaylistOperation
+func redoPlaylistOperation(ctx workflow.Context, internalState *MpeRoomInternalState) brainy.Action {
+	return func(c brainy.Context, e brainy.Event) error {
+		event := e.(MpeRoomRedoEvent)
+
+		operation, ok := internalState.operationsLog.Redo(event.UserID, event.OnlyOwnOperations)
+		if !ok {
+			return nil
+		}
+
+		if err := operation.ApplyTo(&internalState.Tracks); err != nil {
+			fmt.Println("REDO FAILED", err)
+			return nil
+		}
+		internalState.IncrementRevision()
+		appliedOperation := operation
+		appliedOperation.UserID = event.UserID
+		appendToAuditLog(ctx, internalState, appliedOperation, event.DeviceID, shared_mpe.PlaylistAuditLogSourceRedo)
+
+		acknowledgePlaylistOperation(ctx, internalState, operation, event.UserID, event.DeviceID)
+
+		return nil
+	}
+}
@@ -144,6 +144,126 @@ func sendAcknowledgeLeaveActivity(ctx workflow.Context, args activities_mpe.Ackn
 	)
 }
 
+func sendAcknowledgeKickUserActivity(ctx workflow.Context, args activities_mpe.AcknowledgeKickUserActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeKickUserActivity,
+		args,
+	)
+}
+
+func sendRejectJoinActivity(ctx workflow.Context, args activities_mpe.RejectJoinActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.RejectJoinActivity,
+		args,
+	)
+}
+
+func sendAcknowledgeInviteUserActivity(ctx workflow.Context, args activities_mpe.AcknowledgeInviteUserActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeInviteUserActivity,
+		args,
+	)
+}
+
+func sendAcknowledgeRevokeInvitationActivity(ctx workflow.Context, args activities_mpe.AcknowledgeRevokeInvitationActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeRevokeInvitationActivity,
+		args,
+	)
+}
+
+func sendAcknowledgeUpdateUserRoleActivity(ctx workflow.Context, args activities_mpe.AcknowledgeUpdateUserRoleActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeUpdateUserRoleActivity,
+		args,
+	)
+}
+
+func sendRejectConflictingEditionActivity(ctx workflow.Context, args activities_mpe.RejectConflictingEditionActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.RejectConflictingEditionActivity,
+		args,
+	)
+}
+
+func sendRejectUndoRedoActivity(ctx workflow.Context, args activities_mpe.RejectUndoRedoActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.RejectUndoRedoActivity,
+		args,
+	)
+}
+
+func sendReportUnresolvedInitialTracksActivity(ctx workflow.Context, args activities_mpe.ReportUnresolvedInitialTracksActivityArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.ReportUnresolvedInitialTracksActivity,
+		args,
+	)
+}
+
 func sendMtvRoomCreationRequestToServerActivity(ctx workflow.Context, args activities_mpe.SendMtvRoomCreationRequestToServerActivityArgs) {
 	options := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
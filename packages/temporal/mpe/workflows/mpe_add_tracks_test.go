@@ -72,7 +72,7 @@ func (s *AddTracksTestSuite) Test_AddTracks() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -97,7 +97,7 @@ func (s *AddTracksTestSuite) Test_AddTracks() {
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	addTrack := tick * 200
@@ -117,7 +117,7 @@ func (s *AddTracksTestSuite) Test_AddTracks() {
 
 		s.Equal(
 			initialTracksMetadataWithTracksToAddMetadata,
-			mpeState.Tracks,
+			s.tracksMetadata(mpeState.Tracks),
 		)
 	}, checkAddingTracks)
 
@@ -164,7 +164,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistBeforeFetchingInfo
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -181,7 +181,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistBeforeFetchingInfo
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	addTrack := tick * 200
@@ -199,7 +199,97 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistBeforeFetchingInfo
 
 		s.Equal(
 			initialTracksMetadata,
-			mpeState.Tracks,
+			s.tracksMetadata(mpeState.Tracks),
+		)
+	}, checkAddingTracks)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *AddTracksTestSuite) Test_TracksIDsDesignatingTheSameTrackAreNotAddedTwice() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	trackIDToAdd := faker.UUIDHyphenated()
+	trackToAddMetadata := shared.TrackMetadata{
+		ID:         trackIDToAdd,
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		[]string{trackIDToAdd},
+		params.RoomCreatorUserID,
+		roomCreatorDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: []shared.TrackMetadata{trackToAddMetadata},
+		UserID:   params.RoomCreatorUserID,
+		DeviceID: roomCreatorDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	addTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: []string{
+				"youtube:" + initialTracksIDs[0],
+				trackIDToAdd,
+				"youtube:" + trackIDToAdd,
+			},
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+		})
+	}, addTrack)
+
+	checkAddingTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(
+			append(initialTracksMetadata, trackToAddMetadata),
+			s.tracksMetadata(mpeState.Tracks),
 		)
 	}, checkAddingTracks)
 
@@ -278,7 +368,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	//
@@ -318,7 +408,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	addTrackFirstBatch := tick * 200
@@ -348,7 +438,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 
 		s.Equal(
 			initialTracksMetadataWithTracksToAddMetadataSecondBatchWithNonDuplicatedTracksMetadataFirstBatch,
-			mpeState.Tracks,
+			s.tracksMetadata(mpeState.Tracks),
 		)
 		s.Equal(totalDuration, mpeState.PlaylistTotalDuration)
 	}, checkAddingTracks)
@@ -421,7 +511,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	//
@@ -471,7 +561,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	addTrackFirstBatch := tick * 200
@@ -500,7 +590,7 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 
 		s.Equal(
 			initialTracksMetadataWithTracksToAddMetadataSecondBatch,
-			mpeState.Tracks,
+			s.tracksMetadata(mpeState.Tracks),
 		)
 	}, checkAddingTracks)
 
@@ -572,7 +662,7 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 	s.env.OnActivity(
 		a.AcknowledgeJoinActivity,
 		mock.Anything,
@@ -583,7 +673,7 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	addInvitedUser := tick
@@ -653,7 +743,7 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 
 		s.Equal(
 			expectedTracks,
-			mpeState.Tracks,
+			s.tracksMetadata(mpeState.Tracks),
 		)
 	}, checkCreatorAddsTracksWorked)
 
@@ -694,7 +784,7 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 
 		s.Equal(
 			expectedTracks,
-			mpeState.Tracks,
+			s.tracksMetadata(mpeState.Tracks),
 		)
 	}, checkinvitedUserAddsTracksWorked)
 
@@ -746,7 +836,7 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 
 		s.Equal(
 			expectedTracks,
-			mpeState.Tracks,
+			s.tracksMetadata(mpeState.Tracks),
 		)
 	}, checkJoiningUserAddsTracksNotWorked)
 
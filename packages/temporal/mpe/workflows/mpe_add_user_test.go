@@ -47,7 +47,7 @@ func (s *AddUserMpeWorkflowTestUnit) Test_AddUser() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -71,7 +71,7 @@ func (s *AddUserMpeWorkflowTestUnit) Test_AddUser() {
 			RoomID:                        params.RoomID,
 			RoomName:                      params.RoomName,
 			UsersLength:                   1,
-			Tracks:                        expectedTracks,
+			Tracks:                        s.exposedTracksAddedBy(params.RoomCreatorUserID, s.initialNow, expectedTracks),
 			PlaylistTotalDuration:         firstTrackDuration.Milliseconds(),
 		}
 
@@ -0,0 +1,206 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type AuditLogTestSuite struct {
+	UnitTestSuite
+}
+
+func (s *AuditLogTestSuite) Test_AppliedEditionsAreAppendedToAuditLog() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+	otherUserID := faker.UUIDHyphenated()
+	otherUserDeviceID := faker.UUIDHyphenated()
+
+	initialTracksMetadata := make([]shared.TrackMetadata, 0, len(initialTracksIDs))
+	for _, trackID := range initialTracksIDs {
+		initialTracksMetadata = append(initialTracksMetadata, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+	tracksToAddMetadata := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		[]string{tracksToAddMetadata[0].ID},
+		params.RoomCreatorUserID,
+		roomCreatorDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: tracksToAddMetadata,
+		UserID:   params.RoomCreatorUserID,
+		DeviceID: roomCreatorDeviceID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeDeletingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeChangeTrackOrderActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+
+	addTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: []string{tracksToAddMetadata[0].ID},
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, addTrack)
+
+	otherUserJoins := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: otherUserID,
+		})
+	}, otherUserJoins)
+
+	otherUserDeletesTrack := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitDeleteTracksSignal(shared_mpe.NewDeleteTracksSignalArgs{
+			TracksIDs: []string{initialTracksIDs[1]},
+			UserID:    otherUserID,
+			DeviceID:  otherUserDeviceID,
+		})
+	}, otherUserDeletesTrack)
+
+	moveTrack := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:   tracksToAddMetadata[0].ID,
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+			FromIndex: 1,
+			DestIndex: 0,
+		})
+	}, moveTrack)
+
+	// Rejected editions are not logged
+	moveTrackOutOfRange := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:   tracksToAddMetadata[0].ID,
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+			FromIndex: 0,
+			DestIndex: 10,
+		})
+	}, moveTrackOutOfRange)
+
+	undoMove := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUndoSignal(shared_mpe.NewUndoSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+		})
+	}, undoMove)
+
+	checkAuditLog := tick
+	registerDelayedCallbackWrapper(func() {
+		firstPage := s.getAuditLog(1, 2)
+
+		s.True(firstPage.HasMore)
+		s.Len(firstPage.Entries, 2)
+
+		// Undoing the move
+		undoEntry := firstPage.Entries[0]
+		s.Equal(params.RoomCreatorUserID, undoEntry.UserID)
+		s.Equal(roomCreatorDeviceID, undoEntry.DeviceID)
+		s.Equal(shared_mpe.PlaylistOperationMoveTrack, undoEntry.Operation)
+		s.Equal(shared_mpe.PlaylistAuditLogSourceUndo, undoEntry.Source)
+		s.Equal([]string{tracksToAddMetadata[0].ID}, undoEntry.TracksIDs)
+		s.Equal([]int{0, 1}, undoEntry.Positions)
+
+		moveEntry := firstPage.Entries[1]
+		s.Equal(shared_mpe.PlaylistOperationMoveTrack, moveEntry.Operation)
+		s.Equal(shared_mpe.PlaylistAuditLogSourceEdition, moveEntry.Source)
+		s.Equal([]int{1, 0}, moveEntry.Positions)
+
+		secondPage := s.getAuditLog(2, 2)
+
+		s.False(secondPage.HasMore)
+		s.Len(secondPage.Entries, 2)
+
+		deleteEntry := secondPage.Entries[0]
+		s.Equal(otherUserID, deleteEntry.UserID)
+		s.Equal(otherUserDeviceID, deleteEntry.DeviceID)
+		s.Equal(shared_mpe.PlaylistOperationDeleteTracks, deleteEntry.Operation)
+		s.Equal([]string{initialTracksIDs[1]}, deleteEntry.TracksIDs)
+		s.Equal([]int{1}, deleteEntry.Positions)
+
+		addEntry := secondPage.Entries[1]
+		s.Equal(params.RoomCreatorUserID, addEntry.UserID)
+		s.Equal(shared_mpe.PlaylistOperationAddTracks, addEntry.Operation)
+		s.Equal([]string{tracksToAddMetadata[0].ID}, addEntry.TracksIDs)
+		s.Equal([]int{2}, addEntry.Positions)
+
+		addedAt, err := time.Parse(time.RFC3339, addEntry.OccurredAt)
+		s.NoError(err)
+		undoneAt, err := time.Parse(time.RFC3339, undoEntry.OccurredAt)
+		s.NoError(err)
+		s.False(undoneAt.Before(addedAt))
+	}, checkAuditLog)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestAuditLogTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogTestSuite))
+}
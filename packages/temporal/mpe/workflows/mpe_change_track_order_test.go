@@ -68,7 +68,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownAndUp() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -88,7 +88,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownAndUp() {
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	trackToChangeOrder := initialTracksMetadata[0]
@@ -109,12 +109,12 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownAndUp() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
 		expectedIndex := 1
-		currentIndex := IndexOfTrackMedata(mpeState.Tracks, trackToChangeOrder)
+		currentIndex := IndexOfTrackMedata(s.tracksMetadata(mpeState.Tracks), trackToChangeOrder)
 
 		s.Equal(expectedIndex, currentIndex)
 
 		expectedPreviousSecondTracksElementIndex := 0
-		previousSecondTracksElementIndex := IndexOfTrackMedata(mpeState.Tracks, initialTracksMetadata[1])
+		previousSecondTracksElementIndex := IndexOfTrackMedata(s.tracksMetadata(mpeState.Tracks), initialTracksMetadata[1])
 
 		s.Equal(expectedPreviousSecondTracksElementIndex, previousSecondTracksElementIndex)
 	}, checkChangeTrackOrderDownWorked)
@@ -136,10 +136,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownAndUp() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
 		expectedIndex := 0
-		currentIndex := IndexOfTrackMedata(mpeState.Tracks, trackToChangeOrder)
+		currentIndex := IndexOfTrackMedata(s.tracksMetadata(mpeState.Tracks), trackToChangeOrder)
 
 		s.Equal(expectedIndex, currentIndex)
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkChangeTrackOrderUpWorked)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -197,7 +197,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownFailWithInd
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -217,7 +217,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownFailWithInd
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	trackToChangeOrderDown := initialTracksMetadata[2]
@@ -237,7 +237,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownFailWithInd
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkChangeTrackDownFailed)
 
 	trackToChangeOrderUp := initialTracksMetadata[0]
@@ -257,7 +257,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownFailWithInd
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkChangeTrackUpFailed)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -315,7 +315,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownOperation
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -335,7 +335,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownOperation
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	trackToChangeOrderDown := initialTracksMetadata[2]
@@ -356,7 +356,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownOperation
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkChangeTrackOrderDownDidnotWorked)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -414,7 +414,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownTrack() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -434,7 +434,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownTrack() {
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	changeTrackOrderDown := tick
@@ -453,7 +453,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownTrack() {
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkChangeTrackOrderDownDidnotWorked)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -511,7 +511,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexIsOut
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -531,7 +531,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexIsOut
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	trackToChangeOrderDown := initialTracksIDs[0]
@@ -552,7 +552,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexIsOut
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkChangeTrackOrderDownDidnotWorked)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -617,7 +617,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexOnlyI
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -644,7 +644,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexOnlyI
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	addInvitedUser := tick
@@ -697,7 +697,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexOnlyI
 
 		initialTracksMetadata[0], initialTracksMetadata[1] = initialTracksMetadata[1], initialTracksMetadata[0]
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkCreatorChangeTrackOrderDown)
 
 	//InvitedUser change track order
@@ -720,7 +720,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexOnlyI
 
 		initialTracksMetadata[1], initialTracksMetadata[0] = initialTracksMetadata[0], initialTracksMetadata[1]
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkInvitedChangeTrackOrderUp)
 
 	//JoiningUser change track order
@@ -741,7 +741,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexOnlyI
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkjoiningUserChangeTrackOrderUpNotWorked)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -799,7 +799,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackUnknownUse
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -819,7 +819,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackUnknownUse
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	trackToChangeOrderDown := initialTracksIDs[0]
@@ -839,7 +839,7 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackUnknownUse
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkChangeTrackOrderDownDidnotWorked)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -7,6 +7,7 @@ import (
 	"github.com/Devessier/brainy"
 )
 
+//Edition rights are given by the user role, see shared_mpe.MpeRoomUserRole
 func userExistsAndUserCanEditTheTracksList(internalState *MpeRoomInternalState, UserID string) bool {
 	user := internalState.GetUserRelatedInformation(UserID)
 	if user == nil {
@@ -14,15 +15,39 @@ func userExistsAndUserCanEditTheTracksList(internalState *MpeRoomInternalState,
 		return false
 	}
 
-	roomIsOpenAndOnlyInvitedUsersCanEdit := internalState.getRoomIsOpenAndOnlyInvitedUsersCanEdit()
-	if roomIsOpenAndOnlyInvitedUsersCanEdit {
+	if !user.Role.CanEditTracks() {
+		fmt.Printf("userCanPerformEditionOperationOnTheTracksList user role %s cannot edit the tracks list\n", user.Role)
+		return false
+	}
 
-		userHasNotBeenInvited := !user.UserHasBeenInvited
-		userIsNotTheRoomCreator := internalState.initialParams.RoomCreatorUserID != UserID
-		if userHasNotBeenInvited && userIsNotTheRoomCreator {
-			fmt.Println("userCanPerformEditionOperationOnTheTracksList user has not been invited and only invited users can edit is true")
-			return false
-		}
+	return true
+}
+
+func userExistsAndUserCanAddTracks(internalState *MpeRoomInternalState, UserID string) bool {
+	user := internalState.GetUserRelatedInformation(UserID)
+	if user == nil {
+		fmt.Println("userExistsAndUserCanAddTracks user not found")
+		return false
+	}
+
+	if !user.Role.CanAddTracks() {
+		fmt.Printf("userExistsAndUserCanAddTracks user role %s cannot add tracks\n", user.Role)
+		return false
+	}
+
+	return true
+}
+
+func userExistsAndUserCanManageMembers(internalState *MpeRoomInternalState, UserID string) bool {
+	user := internalState.GetUserRelatedInformation(UserID)
+	if user == nil {
+		fmt.Println("userExistsAndUserCanManageMembers user not found")
+		return false
+	}
+
+	if !user.Role.CanManageMembers() {
+		fmt.Printf("userExistsAndUserCanManageMembers user role %s cannot manage members\n", user.Role)
+		return false
 	}
 
 	return true
@@ -74,6 +99,38 @@ func userCanPerformChangeTrackOrderPlaylistEditionOperation(internalState *MpeRo
 	}
 }
 
+//The event listener will send back a reject activity if this condition is false
+func userCanPerformMoveTrackPlaylistEditionOperation(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomMoveTrackEvent)
+
+		userDoesnotExistsOrUserCannotEditTheTracksList := !userExistsAndUserCanEditTheTracksList(internalState, event.UserID)
+		if userDoesnotExistsOrUserCannotEditTheTracksList {
+			fmt.Println("userCanPerformMoveTrackPlaylistEditionOperation user doesnot exist or cannot edit the playlist")
+			return false
+		}
+
+		trackCurrentIndexFromTracksSet := internalState.Tracks.IndexOf(event.TrackID)
+		if trackCurrentIndexFromTracksSet == -1 {
+			fmt.Println("userCanPerformMoveTrackPlaylistEditionOperation track not found")
+			return false
+		}
+
+		givenTrackIndexIsOutdated := trackCurrentIndexFromTracksSet != event.FromIndex
+		if givenTrackIndexIsOutdated {
+			fmt.Println("userCanPerformMoveTrackPlaylistEditionOperation given fromIndex is outdated")
+			return false
+		}
+
+		if destIndexDoesNotFitTracksRange := !internalState.Tracks.GivenIndexFitTracksRange(event.DestIndex); destIndexDoesNotFitTracksRange {
+			fmt.Println("userCanPerformMoveTrackPlaylistEditionOperation destIndex doesNotFitTracksRange")
+			return false
+		}
+
+		return true
+	}
+}
+
 func userCanPerformDeleteTracksOperation(internalState *MpeRoomInternalState) brainy.Cond {
 	return func(c brainy.Context, e brainy.Event) bool {
 		event := e.(MpeRoomDeleteTracksEvent)
@@ -92,9 +149,9 @@ func userCanPerformAddTrackOperation(internalState *MpeRoomInternalState) brainy
 	return func(c brainy.Context, e brainy.Event) bool {
 		event := e.(MpeRoomAddTracksEvent)
 
-		userDoesnotExistsOrUserCannotEditTheTracksList := !userExistsAndUserCanEditTheTracksList(internalState, event.UserID)
-		if userDoesnotExistsOrUserCannotEditTheTracksList {
-			fmt.Println("userCanPerformAddTrackOperation user doesnot exist or cannot edit the playlist")
+		userDoesnotExistsOrUserCannotAddTracks := !userExistsAndUserCanAddTracks(internalState, event.UserID)
+		if userDoesnotExistsOrUserCannotAddTracks {
+			fmt.Println("userCanPerformAddTrackOperation user doesnot exist or cannot add tracks")
 			return false
 		}
 
@@ -102,6 +159,15 @@ func userCanPerformAddTrackOperation(internalState *MpeRoomInternalState) brainy
 	}
 }
 
+//The user may have been kicked or demoted while the tracks were fetched
+func userCanAddFetchedTracks(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomAddedTracksInformationFetchedEvent)
+
+		return userExistsAndUserCanAddTracks(internalState, event.UserID)
+	}
+}
+
 func userIsNotAlreadyInRoom(internalState *MpeRoomInternalState) brainy.Cond {
 	return func(c brainy.Context, e brainy.Event) bool {
 		event := e.(MpeRoomAddUserEvent)
@@ -125,3 +191,215 @@ func userCanExportToMtv(internalState *MpeRoomInternalState) brainy.Cond {
 		return userIsMemberOfTheRoom
 	}
 }
+
+func userIsNotAllowedToJoinRoom(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomAddUserEvent)
+
+		if internalState.UserIsBanned(event.UserID) {
+			fmt.Println("userIsNotAllowedToJoinRoom user has been banned")
+			return true
+		}
+
+		roomIsPrivate := !internalState.initialParams.IsOpen
+		userHasNotBeenInvited := !event.UserHasBeenInvited && !internalState.UserHasBeenInvited(event.UserID)
+		if roomIsPrivate && userHasNotBeenInvited {
+			fmt.Println("userIsNotAllowedToJoinRoom room is private and user has not been invited")
+			return true
+		}
+
+		return false
+	}
+}
+
+func userCanInviteUser(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomInviteUserEvent)
+
+		if !userExistsAndUserCanManageMembers(internalState, event.UserID) {
+			fmt.Println("userCanInviteUser only admins can invite users")
+			return false
+		}
+
+		invitedUserIsTheRoomCreator := internalState.initialParams.RoomCreatorUserID == event.InvitedUserID
+		if invitedUserIsTheRoomCreator {
+			fmt.Println("userCanInviteUser room creator cannot be invited")
+			return false
+		}
+
+		if internalState.UserIsBanned(event.InvitedUserID) {
+			fmt.Println("userCanInviteUser invited user has been banned")
+			return false
+		}
+
+		if internalState.UserHasBeenInvited(event.InvitedUserID) {
+			fmt.Println("userCanInviteUser user has already been invited")
+			return false
+		}
+
+		return true
+	}
+}
+
+func userCanRevokeInvitation(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomRevokeInvitationEvent)
+
+		if !userExistsAndUserCanManageMembers(internalState, event.UserID) {
+			fmt.Println("userCanRevokeInvitation only admins can revoke invitations")
+			return false
+		}
+
+		return internalState.UserHasBeenInvited(event.InvitedUserID)
+	}
+}
+
+func userCanKickUser(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomKickUserEvent)
+
+		if !userExistsAndUserCanManageMembers(internalState, event.UserID) {
+			fmt.Println("userCanKickUser only admins can kick users")
+			return false
+		}
+
+		kickedUserIsTheEmitter := event.KickedUserID == event.UserID
+		if kickedUserIsTheEmitter {
+			fmt.Println("userCanKickUser user cannot kick himself")
+			return false
+		}
+
+		kickedUserIsTheRoomCreator := internalState.initialParams.RoomCreatorUserID == event.KickedUserID
+		if kickedUserIsTheRoomCreator {
+			fmt.Println("userCanKickUser room creator cannot be kicked")
+			return false
+		}
+
+		//A user who is not in the room can still be banned
+		kickedUserIsNotInRoom := internalState.GetUserRelatedInformation(event.KickedUserID) == nil
+		if kickedUserIsNotInRoom && !event.Ban {
+			fmt.Println("userCanKickUser kicked user not found")
+			return false
+		}
+
+		return true
+	}
+}
+
+func userCanUpdateUserRole(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomUpdateUserRoleEvent)
+
+		if !userExistsAndUserCanManageMembers(internalState, event.UserID) {
+			fmt.Println("userCanUpdateUserRole only admins can update users roles")
+			return false
+		}
+
+		if !event.Role.IsValid() {
+			fmt.Println("userCanUpdateUserRole given role is invalid")
+			return false
+		}
+
+		//The room creator always remains an admin
+		updatedUserIsTheRoomCreator := internalState.initialParams.RoomCreatorUserID == event.UpdatedUserID
+		if updatedUserIsTheRoomCreator {
+			fmt.Println("userCanUpdateUserRole room creator role cannot be updated")
+			return false
+		}
+
+		if user := internalState.GetUserRelatedInformation(event.UpdatedUserID); user == nil {
+			fmt.Println("userCanUpdateUserRole updated user not found")
+			return false
+		}
+
+		return true
+	}
+}
+
+func getEditionEventInitiatorAndExpectedRevision(e brainy.Event) (string, string, *int) {
+	switch event := e.(type) {
+	case MpeRoomAddTracksEvent:
+		return event.UserID, event.DeviceID, event.ExpectedRevision
+	case MpeRoomChangeTrackOrderEvent:
+		return event.UserID, event.DeviceID, event.ExpectedRevision
+	case MpeRoomMoveTrackEvent:
+		return event.UserID, event.DeviceID, event.ExpectedRevision
+	case MpeRoomDeleteTracksEvent:
+		return event.UserID, event.DeviceID, event.ExpectedRevision
+	default:
+		return "", "", nil
+	}
+}
+
+//The event listener will send back a conflict activity if this condition is true.
+//Users who are not allowed to edit are rejected by the following transitions,
+//a conflict would tell them the edition could succeed once rebased.
+func editionIsBasedOnOutdatedRevision(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		userID, _, expectedRevision := getEditionEventInitiatorAndExpectedRevision(e)
+
+		userIsAllowedToEdit := userExistsAndUserCanEditTheTracksList(internalState, userID)
+		if _, isAddingTracks := e.(MpeRoomAddTracksEvent); isAddingTracks {
+			userIsAllowedToEdit = userExistsAndUserCanAddTracks(internalState, userID)
+		}
+		if !userIsAllowedToEdit {
+			return false
+		}
+
+		if internalState.RevisionIsOutdated(expectedRevision) {
+			fmt.Printf("editionIsBasedOnOutdatedRevision expected revision %d but current revision is %d\n", *expectedRevision, internalState.revision)
+			return true
+		}
+
+		return false
+	}
+}
+
+//Operations adding tracks require the same rights as adding tracks,
+//others require the same rights as editing the tracks list
+func userCanApplyPlaylistOperation(internalState *MpeRoomInternalState, userID string, operation shared_mpe.PlaylistOperation) bool {
+	if operation.Type == shared_mpe.PlaylistOperationAddTracks {
+		if !userExistsAndUserCanAddTracks(internalState, userID) {
+			return false
+		}
+	} else if !userExistsAndUserCanEditTheTracksList(internalState, userID) {
+		return false
+	}
+
+	if !operation.CanApplyTo(&internalState.Tracks) {
+		fmt.Println("userCanApplyPlaylistOperation operation can not be applied on the current tracks list")
+		return false
+	}
+
+	return true
+}
+
+//The event listener will send back a reject activity if this condition is false
+func userCanUndoPlaylistOperation(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomUndoEvent)
+
+		operation, ok := internalState.operationsLog.NextOperationToUndo(event.UserID, event.OnlyOwnOperations)
+		if !ok {
+			fmt.Println("userCanUndoPlaylistOperation no operation to undo")
+			return false
+		}
+
+		return userCanApplyPlaylistOperation(internalState, event.UserID, operation.Inverse())
+	}
+}
+
+//The event listener will send back a reject activity if this condition is false
+func userCanRedoPlaylistOperation(internalState *MpeRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MpeRoomRedoEvent)
+
+		operation, ok := internalState.operationsLog.NextOperationToRedo(event.UserID, event.OnlyOwnOperations)
+		if !ok {
+			fmt.Println("userCanRedoPlaylistOperation no operation to redo")
+			return false
+		}
+
+		return userCanApplyPlaylistOperation(internalState, event.UserID, operation)
+	}
+}
@@ -64,7 +64,7 @@ func (s *DeleteTracksTestSuite) Test_DeleteTracksAndSendAcknowledgement() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -77,7 +77,7 @@ func (s *DeleteTracksTestSuite) Test_DeleteTracksAndSendAcknowledgement() {
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 		s.Equal(totalDuration, mpeState.PlaylistTotalDuration)
 
 	}, initialTracksFetched)
@@ -147,7 +147,7 @@ func (s *DeleteTracksTestSuite) Test_PreventUnknownUserFromDeletingTracks() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -160,7 +160,7 @@ func (s *DeleteTracksTestSuite) Test_PreventUnknownUserFromDeletingTracks() {
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	deleteTracks := tick * 200
@@ -179,7 +179,7 @@ func (s *DeleteTracksTestSuite) Test_PreventUnknownUserFromDeletingTracks() {
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkDeletedTracks)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -229,7 +229,7 @@ func (s *DeleteTracksTestSuite) Test_AcknowledgeDeletionEvenWhenNoTracksWereDele
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -242,7 +242,7 @@ func (s *DeleteTracksTestSuite) Test_AcknowledgeDeletionEvenWhenNoTracksWereDele
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	deleteTracks := tick * 200
@@ -263,7 +263,7 @@ func (s *DeleteTracksTestSuite) Test_AcknowledgeDeletionEvenWhenNoTracksWereDele
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, checkDeletedTracks)
 
 	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
@@ -314,7 +314,7 @@ func (s *DeleteTracksTestSuite) Test_InRoomWithConstraintsCreatorCanDeleteTracks
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -327,7 +327,7 @@ func (s *DeleteTracksTestSuite) Test_InRoomWithConstraintsCreatorCanDeleteTracks
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	creatorDeletesTracks := tick * 200
@@ -416,7 +416,7 @@ func (s *DeleteTracksTestSuite) Test_InRoomWithConstraintsOnlyInvitedUsersCanDel
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -434,7 +434,7 @@ func (s *DeleteTracksTestSuite) Test_InRoomWithConstraintsOnlyInvitedUsersCanDel
 	registerDelayedCallbackWrapper(func() {
 		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
 
-		s.Equal(initialTracksMetadata, mpeState.Tracks)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
 	}, initialTracksFetched)
 
 	addInvitedUser := tick * 200
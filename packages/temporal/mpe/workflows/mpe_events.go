@@ -10,30 +10,34 @@ import (
 type MpeRoomInitialTrackFetchedEvent struct {
 	brainy.EventWithType
 
-	Tracks []shared.TrackMetadata
+	Tracks   []shared.TrackMetadata
+	Statuses []shared.TrackFetchResult
 }
 
-func NewMpeRoomInitialTracksFetchedEvent(tracks []shared.TrackMetadata) MpeRoomInitialTrackFetchedEvent {
+func NewMpeRoomInitialTracksFetchedEvent(tracks []shared.TrackMetadata, statuses []shared.TrackFetchResult) MpeRoomInitialTrackFetchedEvent {
 	return MpeRoomInitialTrackFetchedEvent{
 		EventWithType: brainy.EventWithType{
 			Event: MpeRoomInitialTracksFetched,
 		},
-		Tracks: tracks,
+		Tracks:   tracks,
+		Statuses: statuses,
 	}
 }
 
 type MpeRoomAddTracksEvent struct {
 	brainy.EventWithType
 
-	TracksIDs []string
-	UserID    string
-	DeviceID  string
+	TracksIDs        []string
+	UserID           string
+	DeviceID         string
+	ExpectedRevision *int
 }
 
 type NewMpeRoomAddTracksEventArgs struct {
-	TracksIDs []string
-	UserID    string
-	DeviceID  string
+	TracksIDs        []string
+	UserID           string
+	DeviceID         string
+	ExpectedRevision *int
 }
 
 func NewMpeRoomAddTracksEvent(args NewMpeRoomAddTracksEventArgs) MpeRoomAddTracksEvent {
@@ -42,9 +46,10 @@ func NewMpeRoomAddTracksEvent(args NewMpeRoomAddTracksEventArgs) MpeRoomAddTrack
 			Event: MpeRoomAddTracksEventType,
 		},
 
-		TracksIDs: args.TracksIDs,
-		UserID:    args.UserID,
-		DeviceID:  args.DeviceID,
+		TracksIDs:        args.TracksIDs,
+		UserID:           args.UserID,
+		DeviceID:         args.DeviceID,
+		ExpectedRevision: args.ExpectedRevision,
 	}
 }
 
@@ -52,12 +57,14 @@ type MpeRoomAddedTracksInformationFetchedEvent struct {
 	brainy.EventWithType
 
 	AddedTracksInformation []shared.TrackMetadata
+	RejectedTracks         []shared_mpe.RejectedTrack
 	UserID                 string
 	DeviceID               string
 }
 
 type NewMpeRoomAddedTracksInformationFetchedEventArgs struct {
 	AddedTracksInformation []shared.TrackMetadata
+	RejectedTracks         []shared_mpe.RejectedTrack
 	UserID                 string
 	DeviceID               string
 }
@@ -69,6 +76,7 @@ func NewMpeRoomAddedTracksInformationFetchedEvent(args NewMpeRoomAddedTracksInfo
 		},
 
 		AddedTracksInformation: args.AddedTracksInformation,
+		RejectedTracks:         args.RejectedTracks,
 		UserID:                 args.UserID,
 		DeviceID:               args.DeviceID,
 	}
@@ -82,6 +90,7 @@ type MpeRoomChangeTrackOrderEvent struct {
 	DeviceID         string
 	OperationToApply shared_mpe.MpeOperationToApplyValue
 	FromIndex        int
+	ExpectedRevision *int
 }
 
 type NewMpeRoomChangeTrackOrderEventArgs struct {
@@ -90,6 +99,7 @@ type NewMpeRoomChangeTrackOrderEventArgs struct {
 	DeviceID         string
 	OperationToApply shared_mpe.MpeOperationToApplyValue
 	FromIndex        int
+	ExpectedRevision *int
 }
 
 func NewMpeRoomChangeTrackOrderEvent(args NewMpeRoomChangeTrackOrderEventArgs) MpeRoomChangeTrackOrderEvent {
@@ -103,21 +113,24 @@ func NewMpeRoomChangeTrackOrderEvent(args NewMpeRoomChangeTrackOrderEventArgs) M
 		DeviceID:         args.DeviceID,
 		OperationToApply: args.OperationToApply,
 		FromIndex:        args.FromIndex,
+		ExpectedRevision: args.ExpectedRevision,
 	}
 }
 
 type MpeRoomDeleteTracksEvent struct {
 	brainy.EventWithType
 
-	TracksIDs []string `validate:"required,dive,required"`
-	UserID    string   `validate:"required,uuid"`
-	DeviceID  string   `validate:"required,uuid"`
+	TracksIDs        []string `validate:"required,dive,required"`
+	UserID           string   `validate:"required,uuid"`
+	DeviceID         string   `validate:"required,uuid"`
+	ExpectedRevision *int
 }
 
 type NewMpeRoomDeleteTracksEventArgs struct {
-	TracksIDs []string
-	UserID    string
-	DeviceID  string
+	TracksIDs        []string
+	UserID           string
+	DeviceID         string
+	ExpectedRevision *int
 }
 
 func NewMpeRoomDeleteTracksEvent(args NewMpeRoomDeleteTracksEventArgs) MpeRoomDeleteTracksEvent {
@@ -126,9 +139,97 @@ func NewMpeRoomDeleteTracksEvent(args NewMpeRoomDeleteTracksEventArgs) MpeRoomDe
 			Event: MpeRoomDeleteTracksEventType,
 		},
 
-		TracksIDs: args.TracksIDs,
-		UserID:    args.UserID,
-		DeviceID:  args.DeviceID,
+		TracksIDs:        args.TracksIDs,
+		UserID:           args.UserID,
+		DeviceID:         args.DeviceID,
+		ExpectedRevision: args.ExpectedRevision,
+	}
+}
+
+type MpeRoomMoveTrackEvent struct {
+	brainy.EventWithType
+
+	TrackID          string
+	UserID           string
+	DeviceID         string
+	FromIndex        int
+	DestIndex        int
+	ExpectedRevision *int
+}
+
+type NewMpeRoomMoveTrackEventArgs struct {
+	TrackID          string
+	UserID           string
+	DeviceID         string
+	FromIndex        int
+	DestIndex        int
+	ExpectedRevision *int
+}
+
+func NewMpeRoomMoveTrackEvent(args NewMpeRoomMoveTrackEventArgs) MpeRoomMoveTrackEvent {
+	return MpeRoomMoveTrackEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MpeRoomMoveTrackEventType,
+		},
+
+		TrackID:          args.TrackID,
+		UserID:           args.UserID,
+		DeviceID:         args.DeviceID,
+		FromIndex:        args.FromIndex,
+		DestIndex:        args.DestIndex,
+		ExpectedRevision: args.ExpectedRevision,
+	}
+}
+
+type MpeRoomUndoEvent struct {
+	brainy.EventWithType
+
+	UserID            string
+	DeviceID          string
+	OnlyOwnOperations bool
+}
+
+type NewMpeRoomUndoEventArgs struct {
+	UserID            string
+	DeviceID          string
+	OnlyOwnOperations bool
+}
+
+func NewMpeRoomUndoEvent(args NewMpeRoomUndoEventArgs) MpeRoomUndoEvent {
+	return MpeRoomUndoEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MpeRoomUndoEventType,
+		},
+
+		UserID:            args.UserID,
+		DeviceID:          args.DeviceID,
+		OnlyOwnOperations: args.OnlyOwnOperations,
+	}
+}
+
+type MpeRoomRedoEvent struct {
+	brainy.EventWithType
+
+	UserID            string
+	DeviceID          string
+	OnlyOwnOperations bool
+}
+
+type NewMpeRoomRedoEventArgs struct {
+	UserID            string
+	DeviceID          string
+	OnlyOwnOperations bool
+}
+
+func NewMpeRoomRedoEvent(args NewMpeRoomRedoEventArgs) MpeRoomRedoEvent {
+	return MpeRoomRedoEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MpeRoomRedoEventType,
+		},
+
+		UserID:            args.UserID,
+		DeviceID:          args.DeviceID,
+		OnlyOwnOperations: args.OnlyOwnOperations,
 	}
 }
 
@@ -175,6 +276,104 @@ func NewMpeRoomRemoveUserEvent(args NewMpeRoomRemoveUserEventArgs) MpeRoomRemove
 	}
 }
 
+type MpeRoomKickUserEvent struct {
+	brainy.EventWithType
+
+	UserID       string `validate:"required,uuid"`
+	KickedUserID string `validate:"required,uuid"`
+	Ban          bool
+}
+
+type NewMpeRoomKickUserEventArgs struct {
+	UserID       string
+	KickedUserID string
+	Ban          bool
+}
+
+func NewMpeRoomKickUserEvent(args NewMpeRoomKickUserEventArgs) MpeRoomKickUserEvent {
+	return MpeRoomKickUserEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MpeRoomKickUserEventType,
+		},
+
+		UserID:       args.UserID,
+		KickedUserID: args.KickedUserID,
+		Ban:          args.Ban,
+	}
+}
+
+type MpeRoomInviteUserEvent struct {
+	brainy.EventWithType
+
+	UserID        string `validate:"required,uuid"`
+	InvitedUserID string `validate:"required,uuid"`
+}
+
+type NewMpeRoomInviteUserEventArgs struct {
+	UserID        string
+	InvitedUserID string
+}
+
+func NewMpeRoomInviteUserEvent(args NewMpeRoomInviteUserEventArgs) MpeRoomInviteUserEvent {
+	return MpeRoomInviteUserEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MpeRoomInviteUserEventType,
+		},
+
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
+
+type MpeRoomRevokeInvitationEvent struct {
+	brainy.EventWithType
+
+	UserID        string `validate:"required,uuid"`
+	InvitedUserID string `validate:"required,uuid"`
+}
+
+type NewMpeRoomRevokeInvitationEventArgs struct {
+	UserID        string
+	InvitedUserID string
+}
+
+func NewMpeRoomRevokeInvitationEvent(args NewMpeRoomRevokeInvitationEventArgs) MpeRoomRevokeInvitationEvent {
+	return MpeRoomRevokeInvitationEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MpeRoomRevokeInvitationEventType,
+		},
+
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
+
+type MpeRoomUpdateUserRoleEvent struct {
+	brainy.EventWithType
+
+	UserID        string                     `validate:"required,uuid"`
+	UpdatedUserID string                     `validate:"required,uuid"`
+	Role          shared_mpe.MpeRoomUserRole `validate:"required"`
+}
+
+type NewMpeRoomUpdateUserRoleEventArgs struct {
+	UserID        string
+	UpdatedUserID string
+	Role          shared_mpe.MpeRoomUserRole
+}
+
+func NewMpeRoomUpdateUserRoleEvent(args NewMpeRoomUpdateUserRoleEventArgs) MpeRoomUpdateUserRoleEvent {
+	return MpeRoomUpdateUserRoleEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MpeRoomUpdateUserRoleEventType,
+		},
+
+		UserID:        args.UserID,
+		UpdatedUserID: args.UpdatedUserID,
+		Role:          args.Role,
+	}
+}
+
 type MpeExportToMtvRoomEvent struct {
 	brainy.EventWithType
 
@@ -56,7 +56,7 @@ func (s *MpeExportToMtvTestUnit) Test_CreatorExportsMpeToMtv() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -116,7 +116,7 @@ func (s *MpeExportToMtvTestUnit) Test_UserInRoomExportsMpeToMtv() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -210,7 +210,7 @@ func (s *MpeExportToMtvTestUnit) Test_UserExportsMpeRoomIntoMtvRoomWithConstrain
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -281,7 +281,7 @@ func (s *MpeExportToMtvTestUnit) Test_UserNotInRoomCanNotExportMpeToMtv() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -310,6 +310,79 @@ func (s *MpeExportToMtvTestUnit) Test_UserNotInRoomCanNotExportMpeToMtv() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
+func (s *MpeExportToMtvTestUnit) Test_ExportWithPlaylistAutofillSeedsMtvRoomWithPlaylistTracks() {
+	var a *activities_mpe.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	initialTracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	mtvRoomOptions := generateMtvRoomCreationOptionsWithPlaceID()
+	mtvRoomOptions.Autofill = &shared_mtv.MtvRoomAutofill{
+		Source: shared_mtv.MtvAutofillSourcePlaylist,
+	}
+
+	expectedMtvRoomOptions := mtvRoomOptions
+	expectedMtvRoomOptions.Autofill = &shared_mtv.MtvRoomAutofill{
+		Source:        shared_mtv.MtvAutofillSourcePlaylist,
+		SeedTracksIDs: initialTracksIDs,
+	}
+
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	defaultDuration := 200 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.SendMtvRoomCreationRequestToServerActivity,
+		mock.Anything,
+		activities_mpe.SendMtvRoomCreationRequestToServerActivityArgs{
+			UserID:         params.RoomCreatorUserID,
+			DeviceID:       roomCreatorDeviceID,
+			MtvRoomOptions: expectedMtvRoomOptions,
+			TracksIDs:      initialTracksIDs,
+		},
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitExportToMtvRoomSignal(shared_mpe.ExportToMtvRoomSignalArgs{
+			UserID:         params.RoomCreatorUserID,
+			DeviceID:       roomCreatorDeviceID,
+			MtvRoomOptions: mtvRoomOptions,
+		})
+	}, init)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
 func TestMpeExportToMtvUnitTestSuite(t *testing.T) {
 	suite.Run(t, new(MpeExportToMtvTestUnit))
 }
@@ -0,0 +1,276 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type InviteUserMpeWorkflowTestUnit struct {
+	UnitTestSuite
+}
+
+func (s *InviteUserMpeWorkflowTestUnit) Test_InvitedUserCanEdit() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		invitedUserID = faker.UUIDHyphenated()
+		joiningUserID = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	params.IsOpenOnlyInvitedUsersCanEdit = true
+	var a *activities_mpe.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	//Specific test activity mocks
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+
+	s.env.OnActivity(
+		a.AcknowledgeInviteUserActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	///
+
+	//Only the room creator can invite users
+	inviteFromNonCreator := defaultDuration * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitInviteUserSignal(shared_mpe.NewInviteUserSignalArgs{
+			UserID:        joiningUserID,
+			InvitedUserID: joiningUserID,
+		})
+	}, inviteFromNonCreator)
+
+	checkNoPendingInvitation := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.Empty(s.getPendingInvitations())
+	}, checkNoPendingInvitation)
+
+	inviteUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitInviteUserSignal(shared_mpe.NewInviteUserSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: invitedUserID,
+		})
+	}, inviteUser)
+
+	checkPendingInvitation := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedPendingInvitations := []shared_mpe.MpeRoomInvitation{
+			{
+				InvitedUserID:  invitedUserID,
+				InvitingUserID: params.RoomCreatorUserID,
+			},
+		}
+
+		s.Equal(expectedPendingInvitations, s.getPendingInvitations())
+	}, checkPendingInvitation)
+
+	//Adonis does not know about the invitation, the workflow does
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             invitedUserID,
+			UserHasBeenInvited: false,
+		})
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		})
+	}, usersJoin)
+
+	checkInvitedUserJoined := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(invitedUserID)
+
+		s.Equal(3, mpeState.UsersLength)
+		s.NotNil(mpeState.UserRelatedInformation)
+		s.True(mpeState.UserRelatedInformation.UserHasBeenInvited)
+		s.Empty(s.getPendingInvitations())
+
+		mpeState = s.getMpeState(joiningUserID)
+
+		s.NotNil(mpeState.UserRelatedInformation)
+		s.False(mpeState.UserRelatedInformation.UserHasBeenInvited)
+	}, checkInvitedUserJoined)
+
+	//Inviting a user who already joined grants him edition rights
+	inviteJoinedUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitInviteUserSignal(shared_mpe.NewInviteUserSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: joiningUserID,
+		})
+	}, inviteJoinedUser)
+
+	checkJoinedUserHasBeenInvited := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(joiningUserID)
+
+		s.NotNil(mpeState.UserRelatedInformation)
+		s.True(mpeState.UserRelatedInformation.UserHasBeenInvited)
+		s.Empty(s.getPendingInvitations())
+	}, checkJoinedUserHasBeenInvited)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *InviteUserMpeWorkflowTestUnit) Test_PrivateRoomRejectsUninvitedUsers() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		revokedUserID   = faker.UUIDHyphenated()
+		uninvitedUserID = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	params.IsOpen = false
+	var a *activities_mpe.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	//Specific test activity mocks
+	s.env.OnActivity(
+		a.AcknowledgeInviteUserActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeRevokeInvitationActivity,
+		mock.Anything,
+		activities_mpe.AcknowledgeRevokeInvitationActivityArgs{
+			State: shared_mpe.MpeRoomExposedState{
+				RoomID:                        params.RoomID,
+				RoomName:                      params.RoomName,
+				RoomCreatorUserID:             params.RoomCreatorUserID,
+				IsOpen:                        params.IsOpen,
+				IsOpenOnlyInvitedUsersCanEdit: params.IsOpenOnlyInvitedUsersCanEdit,
+				UsersLength:                   1,
+				Tracks:                        s.exposedTracksAddedBy(params.RoomCreatorUserID, s.initialNow, tracks),
+				PlaylistTotalDuration:         tracks[0].Duration.Milliseconds(),
+			},
+			InvitedUserID: revokedUserID,
+		},
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.RejectJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	///
+
+	inviteUser := defaultDuration * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitInviteUserSignal(shared_mpe.NewInviteUserSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: revokedUserID,
+		})
+	}, inviteUser)
+
+	revokeInvitation := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitRevokeInvitationSignal(shared_mpe.NewRevokeInvitationSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: revokedUserID,
+		})
+	}, revokeInvitation)
+
+	checkNoPendingInvitation := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.Empty(s.getPendingInvitations())
+	}, checkNoPendingInvitation)
+
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: revokedUserID,
+		})
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: uninvitedUserID,
+		})
+	}, usersJoin)
+
+	checkJoinsWereRejected := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(1, mpeState.UsersLength)
+	}, checkJoinsWereRejected)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestInviteUserUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteUserMpeWorkflowTestUnit))
+}
@@ -0,0 +1,401 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type KickUserMpeWorkflowTestUnit struct {
+	UnitTestSuite
+}
+
+func (s *KickUserMpeWorkflowTestUnit) Test_KickUser() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		joiningUserID = faker.UUIDHyphenated()
+		otherUserID   = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	//Specific test activity mocks
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+
+	s.env.OnActivity(
+		a.AcknowledgeKickUserActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeKickUserActivityArgs) bool {
+			return args.KickedUserID == joiningUserID && !args.Banned && args.State.UsersLength == 2
+		}),
+	).Return(nil).Once()
+	///
+
+	addUsers := defaultDuration * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: otherUserID,
+		})
+	}, addUsers)
+
+	checkJoinWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(3, mpeState.UsersLength)
+	}, checkJoinWorked)
+
+	//Only the room creator can kick users
+	kickFromNonCreator := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitKickUserSignal(shared_mpe.NewKickUserSignalArgs{
+			UserID:       otherUserID,
+			KickedUserID: joiningUserID,
+		})
+	}, kickFromNonCreator)
+
+	checkKickNotWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(3, mpeState.UsersLength)
+	}, checkKickNotWorked)
+
+	kickFromCreator := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitKickUserSignal(shared_mpe.NewKickUserSignalArgs{
+			UserID:       params.RoomCreatorUserID,
+			KickedUserID: joiningUserID,
+		})
+	}, kickFromCreator)
+
+	checkKickWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(2, mpeState.UsersLength)
+	}, checkKickWorked)
+
+	//A kicked user is not banned and can join the room again
+	joinAgain := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, joinAgain)
+
+	checkJoinAgainWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(3, mpeState.UsersLength)
+	}, checkJoinAgainWorked)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *KickUserMpeWorkflowTestUnit) Test_BannedUserCanNotJoinAgain() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		joiningUserID = faker.UUIDHyphenated()
+		unknownUserID = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	//Specific test activity mocks
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeKickUserActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeKickUserActivityArgs) bool {
+			return args.KickedUserID == joiningUserID && args.Banned
+		}),
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeKickUserActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeKickUserActivityArgs) bool {
+			return args.KickedUserID == unknownUserID && args.Banned && args.State.UsersLength == 1
+		}),
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.RejectJoinActivity,
+		mock.Anything,
+		activities_mpe.RejectJoinActivityArgs{
+			RoomID: params.RoomID,
+			UserID: joiningUserID,
+		},
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.RejectJoinActivity,
+		mock.Anything,
+		activities_mpe.RejectJoinActivityArgs{
+			RoomID: params.RoomID,
+			UserID: unknownUserID,
+		},
+	).Return(nil).Once()
+	///
+
+	addUser := defaultDuration * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, addUser)
+
+	banJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitBanUserSignal(shared_mpe.NewBanUserSignalArgs{
+			UserID:       params.RoomCreatorUserID,
+			BannedUserID: joiningUserID,
+		})
+	}, banJoiningUser)
+
+	//A user can be banned before having joined the room
+	banUnknownUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitBanUserSignal(shared_mpe.NewBanUserSignalArgs{
+			UserID:       params.RoomCreatorUserID,
+			BannedUserID: unknownUserID,
+		})
+	}, banUnknownUser)
+
+	checkBanWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(1, mpeState.UsersLength)
+	}, checkBanWorked)
+
+	joinAgain := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: unknownUserID,
+		})
+	}, joinAgain)
+
+	checkJoinWasRejected := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(1, mpeState.UsersLength)
+	}, checkJoinWasRejected)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *KickUserMpeWorkflowTestUnit) Test_TracksFetchedForAKickedUserAreNotAdded() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
+		trackIDToAdd    = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	fetchingDuration := defaultDuration * 100
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	//Specific test activity mocks
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeKickUserActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		[]string{trackIDToAdd},
+		joiningUserID,
+		joiningDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: []shared.TrackMetadata{
+			{
+				ID:         trackIDToAdd,
+				Title:      faker.Word(),
+				ArtistName: faker.Name(),
+				Duration:   random.GenerateRandomDuration(),
+			},
+		},
+		UserID:   joiningUserID,
+		DeviceID: joiningDeviceID,
+	}, nil).Once().After(fetchingDuration)
+	s.env.OnActivity(
+		a.RejectAddingTracksActivity,
+		mock.Anything,
+		activities_mpe.RejectAddingTracksActivityArgs{
+			RoomID:   params.RoomID,
+			UserID:   joiningUserID,
+			DeviceID: joiningDeviceID,
+		},
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
+	///
+
+	addUser := defaultDuration * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, addUser)
+
+	addTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: []string{trackIDToAdd},
+			UserID:    joiningUserID,
+			DeviceID:  joiningDeviceID,
+		})
+	}, addTrack)
+
+	kickWhileFetching := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitKickUserSignal(shared_mpe.NewKickUserSignalArgs{
+			UserID:       params.RoomCreatorUserID,
+			KickedUserID: joiningUserID,
+		})
+	}, kickWhileFetching)
+
+	checkTracksHaveNotBeenAdded := fetchingDuration * 2
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(1, mpeState.UsersLength)
+		s.Equal(tracks, s.tracksMetadata(mpeState.Tracks))
+	}, checkTracksHaveNotBeenAdded)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestKickUserUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(KickUserMpeWorkflowTestUnit))
+}
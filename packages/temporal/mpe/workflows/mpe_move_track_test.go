@@ -0,0 +1,142 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type MoveTrackPlaylistTestSuite struct {
+	UnitTestSuite
+}
+
+func (s *MoveTrackPlaylistTestSuite) Test_MoveTrackToArbitraryIndex() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := make([]shared.TrackMetadata, 0, len(initialTracksIDs))
+	for _, trackID := range initialTracksIDs {
+		initialTracksMetadata = append(initialTracksMetadata, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	expectedMovedTracks := []shared.TrackMetadata{
+		initialTracksMetadata[0],
+		initialTracksMetadata[3],
+		initialTracksMetadata[1],
+		initialTracksMetadata[2],
+	}
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeChangeTrackOrderActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeChangeTrackOrderActivityArgs) bool {
+			return args.UserID == params.RoomCreatorUserID && args.DeviceID == roomCreatorDeviceID
+		}),
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.RejectChangeTrackOrderActivity,
+		mock.Anything,
+		activities_mpe.RejectChangeTrackOrderActivityArgs{
+			RoomID:   params.RoomID,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+		},
+	).Return(nil).Times(2)
+
+	moveTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:   initialTracksIDs[3],
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+			FromIndex: 3,
+			DestIndex: 1,
+		})
+	}, moveTrack)
+
+	checkTrackMoved := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(expectedMovedTracks, s.tracksMetadata(mpeState.Tracks))
+	}, checkTrackMoved)
+
+	// The track is not at this index anymore
+	moveTrackWithOutdatedIndex := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:   initialTracksIDs[3],
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+			FromIndex: 3,
+			DestIndex: 0,
+		})
+	}, moveTrackWithOutdatedIndex)
+
+	moveTrackOutOfRange := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:   initialTracksIDs[0],
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+			FromIndex: 0,
+			DestIndex: len(initialTracksIDs),
+		})
+	}, moveTrackOutOfRange)
+
+	checkNothingChanged := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(expectedMovedTracks, s.tracksMetadata(mpeState.Tracks))
+	}, checkNothingChanged)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestMoveTrackPlaylistTestSuite(t *testing.T) {
+	suite.Run(t, new(MoveTrackPlaylistTestSuite))
+}
@@ -0,0 +1,241 @@
+package mpe
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type RejectedTracksTestSuite struct {
+	UnitTestSuite
+}
+
+func (s *RejectedTracksTestSuite) Test_UnresolvedAddedTracksAreListedInAcknowledgement() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDsToAdd := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	//Only the first track can be added
+	tracksToAddMetadata := []shared.TrackMetadata{
+		{
+			ID:         tracksIDsToAdd[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	expectedRejectedTracks := []shared_mpe.RejectedTrack{
+		{
+			TrackID: tracksIDsToAdd[1],
+			Reason:  shared.TrackFetchStatusNotFound,
+		},
+		{
+			TrackID: tracksIDsToAdd[2],
+			Reason:  shared.TrackFetchStatusUnplayable,
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		tracksIDsToAdd,
+		params.RoomCreatorUserID,
+		roomCreatorDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: tracksToAddMetadata,
+		Statuses: []shared.TrackFetchResult{
+			{
+				TrackID: tracksIDsToAdd[0],
+				Status:  shared.TrackFetchStatusFound,
+			},
+			{
+				TrackID: tracksIDsToAdd[1],
+				Status:  shared.TrackFetchStatusNotFound,
+			},
+			{
+				TrackID: tracksIDsToAdd[2],
+				Status:  shared.TrackFetchStatusUnplayable,
+			},
+		},
+		UserID:   params.RoomCreatorUserID,
+		DeviceID: roomCreatorDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeAddingTracksActivityArgs) bool {
+			return reflect.DeepEqual(expectedRejectedTracks, args.RejectedTracks) &&
+				args.UserID == params.RoomCreatorUserID &&
+				args.DeviceID == roomCreatorDeviceID
+		}),
+	).Return(nil).Once()
+
+	addTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: tracksIDsToAdd,
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, addTracks)
+
+	checkAddedTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(
+			append(initialTracksMetadata, tracksToAddMetadata...),
+			s.tracksMetadata(mpeState.Tracks),
+		)
+	}, checkAddedTracks)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *RejectedTracksTestSuite) Test_AddingOnlyUnresolvedTracksIsRejectedWithReasons() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDsToAdd := []string{
+		faker.UUIDHyphenated(),
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		tracksIDsToAdd,
+		params.RoomCreatorUserID,
+		roomCreatorDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: []shared.TrackMetadata{},
+		Statuses: []shared.TrackFetchResult{
+			{
+				TrackID: tracksIDsToAdd[0],
+				Status:  shared.TrackFetchStatusDurationParseError,
+			},
+		},
+		UserID:   params.RoomCreatorUserID,
+		DeviceID: roomCreatorDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
+
+	s.env.OnActivity(
+		a.RejectAddingTracksActivity,
+		mock.Anything,
+		activities_mpe.RejectAddingTracksActivityArgs{
+			RoomID:   params.RoomID,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+			RejectedTracks: []shared_mpe.RejectedTrack{
+				{
+					TrackID: tracksIDsToAdd[0],
+					Reason:  shared.TrackFetchStatusDurationParseError,
+				},
+			},
+		},
+	).Return(nil).Once()
+
+	addTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: tracksIDsToAdd,
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, addTracks)
+
+	checkNoTrackWasAdded := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
+	}, checkNoTrackWasAdded)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestRejectedTracksTestSuite(t *testing.T) {
+	suite.Run(t, new(RejectedTracksTestSuite))
+}
@@ -48,7 +48,7 @@ func (s *RemoveUserMpeWorkflowTestUnit) Test_RemoveUser() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -81,7 +81,7 @@ func (s *RemoveUserMpeWorkflowTestUnit) Test_RemoveUser() {
 			RoomID:                        params.RoomID,
 			RoomName:                      params.RoomName,
 			UsersLength:                   1,
-			Tracks:                        expectedTracks,
+			Tracks:                        s.exposedTracksAddedBy(params.RoomCreatorUserID, s.initialNow, expectedTracks),
 			PlaylistTotalDuration:         firstTrackDuration.Milliseconds(), //tmp
 		}
 
@@ -0,0 +1,288 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type PlaylistRevisionTestSuite struct {
+	UnitTestSuite
+}
+
+func (s *PlaylistRevisionTestSuite) Test_EditionBasedOnOutdatedRevisionIsRejected() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := make([]shared.TrackMetadata, 0, len(initialTracksIDs))
+	for _, trackID := range initialTracksIDs {
+		initialTracksMetadata = append(initialTracksMetadata, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+	initialRevision := 0
+	revisionAfterMove := 1
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	expectedMovedTracks := []shared.TrackMetadata{
+		initialTracksMetadata[2],
+		initialTracksMetadata[0],
+		initialTracksMetadata[1],
+	}
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeChangeTrackOrderActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectConflictingEditionActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.RejectConflictingEditionActivityArgs) bool {
+			return args.UserID == params.RoomCreatorUserID &&
+				args.DeviceID == roomCreatorDeviceID &&
+				args.ExpectedRevision == initialRevision &&
+				args.State.Revision == revisionAfterMove
+		}),
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeDeletingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	checkInitialRevision := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(initialRevision, mpeState.Revision)
+	}, checkInitialRevision)
+
+	moveTrack := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:          initialTracksIDs[2],
+			UserID:           params.RoomCreatorUserID,
+			DeviceID:         roomCreatorDeviceID,
+			FromIndex:        2,
+			DestIndex:        0,
+			ExpectedRevision: &initialRevision,
+		})
+	}, moveTrack)
+
+	checkRevisionIncremented := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(expectedMovedTracks, s.tracksMetadata(mpeState.Tracks))
+		s.Equal(revisionAfterMove, mpeState.Revision)
+	}, checkRevisionIncremented)
+
+	// The client did not receive the move yet
+	deleteTracksWithOutdatedRevision := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitDeleteTracksSignal(shared_mpe.NewDeleteTracksSignalArgs{
+			TracksIDs:        []string{initialTracksIDs[0]},
+			UserID:           params.RoomCreatorUserID,
+			DeviceID:         roomCreatorDeviceID,
+			ExpectedRevision: &initialRevision,
+		})
+	}, deleteTracksWithOutdatedRevision)
+
+	checkNothingChanged := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(expectedMovedTracks, s.tracksMetadata(mpeState.Tracks))
+		s.Equal(revisionAfterMove, mpeState.Revision)
+	}, checkNothingChanged)
+
+	// Editions that do not specify a revision are always applied
+	deleteTracksWithoutRevision := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitDeleteTracksSignal(shared_mpe.NewDeleteTracksSignalArgs{
+			TracksIDs: []string{initialTracksIDs[0]},
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, deleteTracksWithoutRevision)
+
+	checkTrackDeleted := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal([]shared.TrackMetadata{
+			initialTracksMetadata[2],
+			initialTracksMetadata[1],
+		}, s.tracksMetadata(mpeState.Tracks))
+		s.Equal(revisionAfterMove+1, mpeState.Revision)
+	}, checkTrackDeleted)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *PlaylistRevisionTestSuite) Test_UnauthorizedEditionBasedOnOutdatedRevisionIsNotAConflict() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	var (
+		viewerUserID   = faker.UUIDHyphenated()
+		viewerDeviceID = faker.UUIDHyphenated()
+	)
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := make([]shared.TrackMetadata, 0, len(initialTracksIDs))
+	for _, trackID := range initialTracksIDs {
+		initialTracksMetadata = append(initialTracksMetadata, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+	initialRevision := 0
+	revisionAfterMove := 1
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateUserRoleActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeChangeTrackOrderActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectChangeTrackOrderActivity,
+		mock.Anything,
+		activities_mpe.RejectChangeTrackOrderActivityArgs{
+			DeviceID: viewerDeviceID,
+			UserID:   viewerUserID,
+			RoomID:   params.RoomID,
+		},
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectConflictingEditionActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
+
+	addViewer := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: viewerUserID,
+		})
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			UpdatedUserID: viewerUserID,
+			Role:          shared_mpe.MpeRoomUserRoleViewer,
+		})
+	}, addViewer)
+
+	moveTrack := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:          initialTracksIDs[2],
+			UserID:           params.RoomCreatorUserID,
+			DeviceID:         roomCreatorDeviceID,
+			FromIndex:        2,
+			DestIndex:        0,
+			ExpectedRevision: &initialRevision,
+		})
+	}, moveTrack)
+
+	// The viewer is rejected for lacking the rights, not for the outdated revision
+	viewerMovesTrackWithOutdatedRevision := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:          initialTracksIDs[0],
+			UserID:           viewerUserID,
+			DeviceID:         viewerDeviceID,
+			FromIndex:        0,
+			DestIndex:        2,
+			ExpectedRevision: &initialRevision,
+		})
+	}, viewerMovesTrackWithOutdatedRevision)
+
+	checkNothingChanged := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(revisionAfterMove, mpeState.Revision)
+	}, checkNothingChanged)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestPlaylistRevisionTestSuite(t *testing.T) {
+	suite.Run(t, new(PlaylistRevisionTestSuite))
+}
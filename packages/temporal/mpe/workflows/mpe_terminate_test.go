@@ -40,7 +40,7 @@ func (s *TerminateWorkflowTestUnit) Test_MpeRoomExitsAfterTerminateSignal() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -55,7 +55,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflow() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -74,7 +74,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflow() {
 			RoomID:                        params.RoomID,
 			RoomName:                      params.RoomName,
 			UsersLength:                   1,
-			Tracks:                        expectedTracks,
+			Tracks:                        s.exposedTracksAddedBy(params.RoomCreatorUserID, s.initialNow, expectedTracks),
 			PlaylistTotalDuration:         firstTrackDuration.Milliseconds() + secondTrackDuration.Milliseconds(),
 		}
 
@@ -144,7 +144,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowWithSeveralInitialTrac
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -162,7 +162,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowWithSeveralInitialTrac
 			RoomID:                        params.RoomID,
 			RoomName:                      params.RoomName,
 			UsersLength:                   1,
-			Tracks:                        expectedTracks,
+			Tracks:                        s.exposedTracksAddedBy(params.RoomCreatorUserID, s.initialNow, expectedTracks),
 			PlaylistTotalDuration:         totalDuration,
 		}
 
@@ -232,7 +232,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_GetStateProvidesUserRelatedInformation(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -245,6 +245,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_GetStateProvidesUserRelatedInformation(
 		expectedUserRelatedInformation := shared_mpe.InternalStateUser{
 			UserHasBeenInvited: false,
 			UserID:             params.RoomCreatorUserID,
+			Role:               shared_mpe.MpeRoomUserRoleAdmin,
 		}
 
 		s.Equal(&expectedUserRelatedInformation, mpeState.UserRelatedInformation)
@@ -325,7 +326,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowFetchInitialTrackFaile
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(nil, nil).Once()
+	).Return(activities.FetchedTracksInformation{}, nil).Once()
 
 	checkOnlyOneUser := defaultDuration
 	registerDelayedCallbackWrapper(func() {
@@ -339,7 +340,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowFetchInitialTrackFaile
 			RoomID:                        params.RoomID,
 			RoomName:                      params.RoomName,
 			UsersLength:                   1,
-			Tracks:                        expectedTracks,
+			Tracks:                        s.exposedTracksAddedBy(params.RoomCreatorUserID, s.initialNow, expectedTracks),
 			PlaylistTotalDuration:         0,
 		}
 
@@ -376,7 +377,7 @@ func (s *CreateMpeWorkflowTestUnit) Test_MtvRoomPanicAfterUnkownWorkflowSignal()
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		initialTracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -0,0 +1,151 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type TrackAttributionTestSuite struct {
+	UnitTestSuite
+}
+
+func (s *TrackAttributionTestSuite) Test_TracksAreAttributedToTheUserWhoAddedThem() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	var (
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         initialTracksIDs[1],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDsToAdd := []string{
+		faker.UUIDHyphenated(),
+	}
+	tracksToAddMetadata := []shared.TrackMetadata{
+		{
+			ID:         tracksIDsToAdd[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		tracksIDsToAdd,
+		joiningUserID,
+		joiningDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: tracksToAddMetadata,
+		UserID:   joiningUserID,
+		DeviceID: joiningDeviceID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	checkInitialTracksAttribution := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(
+			s.exposedTracksAddedBy(params.RoomCreatorUserID, s.initialNow, initialTracksMetadata),
+			mpeState.Tracks,
+		)
+	}, checkInitialTracksAttribution)
+
+	addUser := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, addUser)
+
+	joiningUserAddsTracks := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: tracksIDsToAdd,
+			UserID:    joiningUserID,
+			DeviceID:  joiningDeviceID,
+		})
+	}, joiningUserAddsTracks)
+
+	checkAddedTracksAttribution := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(append(initialTracksMetadata, tracksToAddMetadata...), s.tracksMetadata(mpeState.Tracks))
+		s.Equal(params.RoomCreatorUserID, mpeState.Tracks[0].AddedByUserID)
+		s.Equal(params.RoomCreatorUserID, mpeState.Tracks[1].AddedByUserID)
+		s.Equal(joiningUserID, mpeState.Tracks[2].AddedByUserID)
+
+		initialTrackAddedAt, err := time.Parse(time.RFC3339, mpeState.Tracks[0].AddedAt)
+		s.NoError(err)
+		addedTrackAddedAt, err := time.Parse(time.RFC3339, mpeState.Tracks[2].AddedAt)
+		s.NoError(err)
+		s.False(addedTrackAddedAt.Before(initialTrackAddedAt))
+	}, checkAddedTracksAttribution)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestTrackAttributionTestSuite(t *testing.T) {
+	suite.Run(t, new(TrackAttributionTestSuite))
+}
@@ -0,0 +1,305 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type UndoRedoPlaylistTestSuite struct {
+	UnitTestSuite
+}
+
+func (s *UndoRedoPlaylistTestSuite) Test_UndoAndRedoTracksDeletion() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+	otherUserID := faker.UUIDHyphenated()
+	otherUserDeviceID := faker.UUIDHyphenated()
+
+	initialTracksMetadata := make([]shared.TrackMetadata, 0, len(initialTracksIDs))
+	for _, trackID := range initialTracksIDs {
+		initialTracksMetadata = append(initialTracksMetadata, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeDeletingTracksActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeDeletingTracksActivityArgs) bool {
+			return args.UserID == params.RoomCreatorUserID && args.DeviceID == roomCreatorDeviceID
+		}),
+	).Return(nil).Times(2)
+	// Undoing a deletion is acknowledged as adding tracks
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeAddingTracksActivityArgs) bool {
+			return args.UserID == params.RoomCreatorUserID && args.DeviceID == roomCreatorDeviceID
+		}),
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeChangeTrackOrderActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.AcknowledgeChangeTrackOrderActivityArgs) bool {
+			return args.UserID == otherUserID && args.DeviceID == otherUserDeviceID
+		}),
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectUndoRedoActivity,
+		mock.Anything,
+		activities_mpe.RejectUndoRedoActivityArgs{
+			RoomID:   params.RoomID,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+		},
+	).Return(nil).Once()
+
+	deleteTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitDeleteTracksSignal(shared_mpe.NewDeleteTracksSignalArgs{
+			TracksIDs: []string{initialTracksIDs[1]},
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, deleteTrack)
+
+	undoDeletion := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUndoSignal(shared_mpe.NewUndoSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+		})
+	}, undoDeletion)
+
+	checkTrackRestoredAtItsPosition := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
+	}, checkTrackRestoredAtItsPosition)
+
+	redoDeletion := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitRedoSignal(shared_mpe.NewRedoSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+		})
+	}, redoDeletion)
+
+	checkTrackDeletedAgain := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal([]shared.TrackMetadata{
+			initialTracksMetadata[0],
+			initialTracksMetadata[2],
+		}, s.tracksMetadata(mpeState.Tracks))
+	}, checkTrackDeletedAgain)
+
+	otherUserJoins := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: otherUserID,
+		})
+	}, otherUserJoins)
+
+	otherUserMovesTrack := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitMoveTrackSignal(shared_mpe.NewMoveTrackSignalArgs{
+			TrackID:   initialTracksIDs[2],
+			UserID:    otherUserID,
+			DeviceID:  otherUserDeviceID,
+			FromIndex: 1,
+			DestIndex: 0,
+		})
+	}, otherUserMovesTrack)
+
+	// The move of the other user is skipped
+	undoOwnOperation := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUndoSignal(shared_mpe.NewUndoSignalArgs{
+			UserID:            params.RoomCreatorUserID,
+			DeviceID:          roomCreatorDeviceID,
+			OnlyOwnOperations: true,
+		})
+	}, undoOwnOperation)
+
+	checkOnlyOwnOperationUndone := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal([]shared.TrackMetadata{
+			initialTracksMetadata[2],
+			initialTracksMetadata[1],
+			initialTracksMetadata[0],
+		}, s.tracksMetadata(mpeState.Tracks))
+	}, checkOnlyOwnOperationUndone)
+
+	undoWithoutOwnOperationLeft := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUndoSignal(shared_mpe.NewUndoSignalArgs{
+			UserID:            params.RoomCreatorUserID,
+			DeviceID:          roomCreatorDeviceID,
+			OnlyOwnOperations: true,
+		})
+	}, undoWithoutOwnOperationLeft)
+
+	checkNothingChanged := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal([]shared.TrackMetadata{
+			initialTracksMetadata[2],
+			initialTracksMetadata[1],
+			initialTracksMetadata[0],
+		}, s.tracksMetadata(mpeState.Tracks))
+	}, checkNothingChanged)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UndoRedoPlaylistTestSuite) Test_ViewerCanNotUndo() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+	viewerUserID := faker.UUIDHyphenated()
+	viewerDeviceID := faker.UUIDHyphenated()
+
+	initialTracksMetadata := make([]shared.TrackMetadata, 0, len(initialTracksIDs))
+	for _, trackID := range initialTracksIDs {
+		initialTracksMetadata = append(initialTracksMetadata, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeDeletingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectUndoRedoActivity,
+		mock.Anything,
+		activities_mpe.RejectUndoRedoActivityArgs{
+			RoomID:   params.RoomID,
+			UserID:   viewerUserID,
+			DeviceID: viewerDeviceID,
+		},
+	).Return(nil).Once()
+
+	deleteTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitDeleteTracksSignal(shared_mpe.NewDeleteTracksSignalArgs{
+			TracksIDs: []string{initialTracksIDs[0]},
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, deleteTrack)
+
+	viewerJoins := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: viewerUserID,
+		})
+	}, viewerJoins)
+
+	demoteToViewer := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			UpdatedUserID: viewerUserID,
+			Role:          shared_mpe.MpeRoomUserRoleViewer,
+		})
+	}, demoteToViewer)
+
+	viewerUndoes := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUndoSignal(shared_mpe.NewUndoSignalArgs{
+			UserID:   viewerUserID,
+			DeviceID: viewerDeviceID,
+		})
+	}, viewerUndoes)
+
+	checkNothingChanged := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal([]shared.TrackMetadata{
+			initialTracksMetadata[1],
+		}, s.tracksMetadata(mpeState.Tracks))
+	}, checkNothingChanged)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestUndoRedoPlaylistTestSuite(t *testing.T) {
+	suite.Run(t, new(UndoRedoPlaylistTestSuite))
+}
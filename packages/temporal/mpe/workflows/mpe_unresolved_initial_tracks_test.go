@@ -0,0 +1,147 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type UnresolvedInitialTracksTestSuite struct {
+	UnitTestSuite
+}
+
+func (s *UnresolvedInitialTracksTestSuite) Test_UnresolvedInitialTracksAreReported() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	//Only the second track exists
+	resolvedTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[1],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{
+		Metadata: resolvedTracksMetadata,
+		Statuses: []shared.TrackFetchResult{
+			{TrackID: initialTracksIDs[0], Status: shared.TrackFetchStatusNotFound},
+			{TrackID: initialTracksIDs[1], Status: shared.TrackFetchStatusFound},
+			{TrackID: initialTracksIDs[2], Status: shared.TrackFetchStatusInvalidID},
+		},
+	}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.ReportUnresolvedInitialTracksActivity,
+		mock.Anything,
+		activities_mpe.ReportUnresolvedInitialTracksActivityArgs{
+			RoomID: params.RoomID,
+			UserID: params.RoomCreatorUserID,
+			UnresolvedTracksIDs: []string{
+				initialTracksIDs[0],
+				initialTracksIDs[2],
+			},
+			RejectedTracks: []shared_mpe.RejectedTrack{
+				{TrackID: initialTracksIDs[0], Reason: shared.TrackFetchStatusNotFound},
+				{TrackID: initialTracksIDs[2], Reason: shared.TrackFetchStatusInvalidID},
+			},
+		},
+	).Return(nil).Once()
+
+	checkOnlyResolvedTracksAreInPlaylist := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(resolvedTracksMetadata, s.tracksMetadata(mpeState.Tracks))
+	}, checkOnlyResolvedTracksAreInPlaylist)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnresolvedInitialTracksTestSuite) Test_NothingIsReportedWhenAllInitialTracksAreResolved() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	tracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracksMetadata}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.ReportUnresolvedInitialTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
+
+	checkTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(tracksMetadata, s.tracksMetadata(mpeState.Tracks))
+	}, checkTracks)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestUnresolvedInitialTracksTestSuite(t *testing.T) {
+	suite.Run(t, new(UnresolvedInitialTracksTestSuite))
+}
@@ -0,0 +1,435 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type UserRoleMpeWorkflowTestUnit struct {
+	UnitTestSuite
+}
+
+func (s *UserRoleMpeWorkflowTestUnit) Test_UserRoleRestrictsTracksListOperations() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDsToAdd := []string{
+		faker.UUIDHyphenated(),
+	}
+	tracksToAddMetadata := []shared.TrackMetadata{
+		{
+			ID:         tracksIDsToAdd[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeUpdateUserRoleActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+
+	s.env.OnActivity(
+		a.RejectAddingTracksActivity,
+		mock.Anything,
+		activities_mpe.RejectAddingTracksActivityArgs{
+			RoomID:   params.RoomID,
+			UserID:   joiningUserID,
+			DeviceID: joiningDeviceID,
+		},
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		tracksIDsToAdd,
+		joiningUserID,
+		joiningDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: tracksToAddMetadata,
+		UserID:   joiningUserID,
+		DeviceID: joiningDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeDeletingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
+
+	addUser := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, addUser)
+
+	checkDefaultRole := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(joiningUserID)
+
+		s.NotNil(mpeState.UserRelatedInformation)
+		s.Equal(shared_mpe.MpeRoomUserRoleEditor, mpeState.UserRelatedInformation.Role)
+	}, checkDefaultRole)
+
+	// 1. A viewer cannot add tracks.
+	updateRoleToViewer := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			UpdatedUserID: joiningUserID,
+			Role:          shared_mpe.MpeRoomUserRoleViewer,
+		})
+	}, updateRoleToViewer)
+
+	viewerAddsTracks := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: tracksIDsToAdd,
+			UserID:    joiningUserID,
+			DeviceID:  joiningDeviceID,
+		})
+	}, viewerAddsTracks)
+
+	checkTracksNotAdded := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(joiningUserID)
+
+		s.Equal(shared_mpe.MpeRoomUserRoleViewer, mpeState.UserRelatedInformation.Role)
+		s.Equal(initialTracksMetadata, s.tracksMetadata(mpeState.Tracks))
+	}, checkTracksNotAdded)
+
+	// 2. A contributor can add tracks but cannot delete them.
+	updateRoleToContributor := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			UpdatedUserID: joiningUserID,
+			Role:          shared_mpe.MpeRoomUserRoleContributor,
+		})
+	}, updateRoleToContributor)
+
+	contributorAddsTracks := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: tracksIDsToAdd,
+			UserID:    joiningUserID,
+			DeviceID:  joiningDeviceID,
+		})
+	}, contributorAddsTracks)
+
+	checkTracksAdded := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(append(initialTracksMetadata, tracksToAddMetadata...), s.tracksMetadata(mpeState.Tracks))
+	}, checkTracksAdded)
+
+	contributorDeletesTracks := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitDeleteTracksSignal(shared_mpe.NewDeleteTracksSignalArgs{
+			TracksIDs: initialTracksIDs,
+			UserID:    joiningUserID,
+			DeviceID:  joiningDeviceID,
+		})
+	}, contributorDeletesTracks)
+
+	checkTracksNotDeleted := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(append(initialTracksMetadata, tracksToAddMetadata...), s.tracksMetadata(mpeState.Tracks))
+	}, checkTracksNotDeleted)
+
+	// 3. An admin cannot update the room creator role.
+	updateRoleToAdmin := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			UpdatedUserID: joiningUserID,
+			Role:          shared_mpe.MpeRoomUserRoleAdmin,
+		})
+	}, updateRoleToAdmin)
+
+	adminDemotesCreator := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        joiningUserID,
+			UpdatedUserID: params.RoomCreatorUserID,
+			Role:          shared_mpe.MpeRoomUserRoleViewer,
+		})
+	}, adminDemotesCreator)
+
+	checkCreatorIsStillAdmin := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(params.RoomCreatorUserID)
+
+		s.Equal(shared_mpe.MpeRoomUserRoleAdmin, mpeState.UserRelatedInformation.Role)
+	}, checkCreatorIsStillAdmin)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UserRoleMpeWorkflowTestUnit) Test_OnlyInvitedUsersCanEditGivesViewerRole() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		invitedUserID   = faker.UUIDHyphenated()
+		uninvitedUserID = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	params.IsOpenOnlyInvitedUsersCanEdit = true
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+
+	addUsers := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             invitedUserID,
+			UserHasBeenInvited: true,
+		})
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             uninvitedUserID,
+			UserHasBeenInvited: false,
+		})
+	}, addUsers)
+
+	checkRoles := tick
+	registerDelayedCallbackWrapper(func() {
+		invitedUserState := s.getMpeState(invitedUserID)
+		s.Equal(shared_mpe.MpeRoomUserRoleEditor, invitedUserState.UserRelatedInformation.Role)
+
+		uninvitedUserState := s.getMpeState(uninvitedUserID)
+		s.Equal(shared_mpe.MpeRoomUserRoleViewer, uninvitedUserState.UserRelatedInformation.Role)
+	}, checkRoles)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UserRoleMpeWorkflowTestUnit) Test_ExplicitRoleIsKeptWhenInvitationsChange() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	var (
+		joiningUserID = faker.UUIDHyphenated()
+	)
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateUserRoleActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeInviteUserActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeRevokeInvitationActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	addUser := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, addUser)
+
+	// 1. An invitation does not promote a user explicitly demoted to viewer.
+	demoteToViewer := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			UpdatedUserID: joiningUserID,
+			Role:          shared_mpe.MpeRoomUserRoleViewer,
+		})
+	}, demoteToViewer)
+
+	inviteUser := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitInviteUserSignal(shared_mpe.NewInviteUserSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: joiningUserID,
+		})
+	}, inviteUser)
+
+	checkRoleAfterInvitation := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(joiningUserID)
+
+		s.NotNil(mpeState.UserRelatedInformation)
+		s.True(mpeState.UserRelatedInformation.UserHasBeenInvited)
+		s.Equal(shared_mpe.MpeRoomUserRoleViewer, mpeState.UserRelatedInformation.Role)
+	}, checkRoleAfterInvitation)
+
+	// 2. Revoking the invitation does not promote a user explicitly made contributor.
+	updateToContributor := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateUserRoleSignal(shared_mpe.NewUpdateUserRoleSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			UpdatedUserID: joiningUserID,
+			Role:          shared_mpe.MpeRoomUserRoleContributor,
+		})
+	}, updateToContributor)
+
+	revokeInvitation := tick
+	registerDelayedCallbackWrapper(func() {
+		s.emitRevokeInvitationSignal(shared_mpe.NewRevokeInvitationSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: joiningUserID,
+		})
+	}, revokeInvitation)
+
+	checkRoleAfterRevocation := tick
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(joiningUserID)
+
+		s.NotNil(mpeState.UserRelatedInformation)
+		s.False(mpeState.UserRelatedInformation.UserHasBeenInvited)
+		s.Equal(shared_mpe.MpeRoomUserRoleContributor, mpeState.UserRelatedInformation.Role)
+	}, checkRoleAfterRevocation)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestUserRoleUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UserRoleMpeWorkflowTestUnit))
+}
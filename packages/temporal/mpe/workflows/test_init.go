@@ -18,6 +18,8 @@ type UnitTestSuite struct {
 	testsuite.WorkflowTestSuite
 
 	env *testsuite.TestWorkflowEnvironment
+	//Time returned by the mocked TimeWrapper before any delayed callback
+	initialNow time.Time
 }
 
 func (s *UnitTestSuite) SetupTest() {
@@ -31,6 +33,7 @@ func (s *UnitTestSuite) AfterTest(suiteName, testName string) {
 func (s *UnitTestSuite) initTestEnv() (func(), func(callback func(), durationToAdd time.Duration)) {
 	var temporalTemporality time.Duration
 	now := time.Now()
+	s.initialNow = now
 	oldImplem := TimeWrapper
 	timeMock := new(mocks.TimeWrapperType)
 	timeMockExecute := timeMock.On("Execute")
@@ -98,6 +101,59 @@ func (s *UnitTestSuite) getMpeState(userID string) shared_mpe.MpeRoomExposedStat
 	return mpeState
 }
 
+func (s *UnitTestSuite) getPendingInvitations() []shared_mpe.MpeRoomInvitation {
+	var pendingInvitations []shared_mpe.MpeRoomInvitation
+
+	res, err := s.env.QueryWorkflow(shared_mpe.MpeGetPendingInvitationsQuery)
+	s.NoError(err)
+
+	err = res.Get(&pendingInvitations)
+	s.NoError(err)
+
+	return pendingInvitations
+}
+
+func (s *UnitTestSuite) getAuditLog(page int, limit int) shared_mpe.MpeRoomExposedAuditLog {
+	var auditLog shared_mpe.MpeRoomExposedAuditLog
+
+	res, err := s.env.QueryWorkflow(shared_mpe.MpeGetAuditLogQuery, page, limit)
+	s.NoError(err)
+
+	err = res.Get(&auditLog)
+	s.NoError(err)
+
+	return auditLog
+}
+
+//Strips the attribution of the exposed tracks after checking
+//their added at date is a valid RFC3339 date
+func (s *UnitTestSuite) tracksMetadata(tracks []shared_mpe.ExposedPlaylistTrack) []shared.TrackMetadata {
+	tracksMetadata := make([]shared.TrackMetadata, 0, len(tracks))
+
+	for _, track := range tracks {
+		_, err := time.Parse(time.RFC3339, track.AddedAt)
+		s.NoError(err)
+
+		tracksMetadata = append(tracksMetadata, track.TrackMetadata)
+	}
+
+	return tracksMetadata
+}
+
+func (s *UnitTestSuite) exposedTracksAddedBy(userID string, addedAt time.Time, tracks []shared.TrackMetadata) []shared_mpe.ExposedPlaylistTrack {
+	exposedTracks := make([]shared_mpe.ExposedPlaylistTrack, 0, len(tracks))
+
+	for _, track := range tracks {
+		exposedTracks = append(exposedTracks, shared_mpe.PlaylistTrack{
+			TrackMetadata: track,
+			AddedByUserID: userID,
+			AddedAt:       addedAt,
+		}.Export())
+	}
+
+	return exposedTracks
+}
+
 func (s *UnitTestSuite) emitAddTrackSignal(args shared_mpe.NewAddTracksSignalArgs) {
 	addTracksSignal := shared_mpe.NewAddTracksSignal(args)
 	s.env.SignalWorkflow(shared_mpe.SignalChannelName, addTracksSignal)
@@ -108,11 +164,26 @@ func (s *UnitTestSuite) emitChangeTrackOrder(args shared_mpe.NewChangeTrackOrder
 	s.env.SignalWorkflow(shared_mpe.SignalChannelName, changeTrackOrderSignal)
 }
 
+func (s *UnitTestSuite) emitMoveTrackSignal(args shared_mpe.NewMoveTrackSignalArgs) {
+	moveTrackSignal := shared_mpe.NewMoveTrackSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, moveTrackSignal)
+}
+
 func (s *UnitTestSuite) emitDeleteTracksSignal(args shared_mpe.NewDeleteTracksSignalArgs) {
 	deleteTracksSignal := shared_mpe.NewDeleteTracksSignal(args)
 	s.env.SignalWorkflow(shared_mpe.SignalChannelName, deleteTracksSignal)
 }
 
+func (s *UnitTestSuite) emitUndoSignal(args shared_mpe.NewUndoSignalArgs) {
+	undoSignal := shared_mpe.NewUndoSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, undoSignal)
+}
+
+func (s *UnitTestSuite) emitRedoSignal(args shared_mpe.NewRedoSignalArgs) {
+	redoSignal := shared_mpe.NewRedoSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, redoSignal)
+}
+
 func (s *UnitTestSuite) emitAddUserSignal(args shared_mpe.NewAddUserSignalArgs) {
 	addUserSignal := shared_mpe.NewAddUserSignal(args)
 	s.env.SignalWorkflow(shared_mpe.SignalChannelName, addUserSignal)
@@ -123,6 +194,31 @@ func (s *UnitTestSuite) emitRemoveUserSignal(args shared_mpe.NewRemoveUserSignal
 	s.env.SignalWorkflow(shared_mpe.SignalChannelName, removeUserSignal)
 }
 
+func (s *UnitTestSuite) emitKickUserSignal(args shared_mpe.NewKickUserSignalArgs) {
+	kickUserSignal := shared_mpe.NewKickUserSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, kickUserSignal)
+}
+
+func (s *UnitTestSuite) emitBanUserSignal(args shared_mpe.NewBanUserSignalArgs) {
+	banUserSignal := shared_mpe.NewBanUserSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, banUserSignal)
+}
+
+func (s *UnitTestSuite) emitInviteUserSignal(args shared_mpe.NewInviteUserSignalArgs) {
+	inviteUserSignal := shared_mpe.NewInviteUserSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, inviteUserSignal)
+}
+
+func (s *UnitTestSuite) emitRevokeInvitationSignal(args shared_mpe.NewRevokeInvitationSignalArgs) {
+	revokeInvitationSignal := shared_mpe.NewRevokeInvitationSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, revokeInvitationSignal)
+}
+
+func (s *UnitTestSuite) emitUpdateUserRoleSignal(args shared_mpe.NewUpdateUserRoleSignalArgs) {
+	updateUserRoleSignal := shared_mpe.NewUpdateUserRoleSignal(args)
+	s.env.SignalWorkflow(shared_mpe.SignalChannelName, updateUserRoleSignal)
+}
+
 func (s *UnitTestSuite) emitUnkownSignal() {
 	fmt.Println("-----EMIT UNKOWN SIGNAL CALLED IN TEST-----")
 	unkownSignal := struct {
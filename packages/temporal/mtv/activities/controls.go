@@ -177,6 +177,77 @@ func (a *Activities) UserVoteForTrackAcknowledgement(ctx context.Context, state
 	return err
 }
 
+func (a *Activities) UserUnvoteForTrackAcknowledgement(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	requestBody := state
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-user-unvote-for-track"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+func (a *Activities) UserDownvoteTrackAcknowledgement(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	requestBody := state
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-user-downvote-track"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type NotifyTrackEvictedArgs struct {
+	State          shared_mtv.MtvRoomExposedState `json:"state"`
+	EvictedTrackID string                         `json:"evictedTrackID"`
+}
+
+func (a *Activities) NotifyTrackEvictedActivity(ctx context.Context, args NotifyTrackEvictedArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/track-evicted"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
 func (a *Activities) ChangeUserEmittingDeviceActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
 	requestBody := state
 
@@ -224,6 +295,8 @@ func (a *Activities) NotifySuggestOrVoteUpdateActivity(ctx context.Context, stat
 type AcknowledgeTracksSuggestionArgs struct {
 	State    shared_mtv.MtvRoomExposedState `json:"state"`
 	DeviceID string                         `json:"deviceID"`
+	// Suggested tracks whose metadata could not be fetched, with the reason why
+	RejectedTracks []shared_mtv.RejectedTrack `json:"rejectedTracks"`
 }
 
 func (a *Activities) AcknowledgeTracksSuggestion(ctx context.Context, args AcknowledgeTracksSuggestionArgs) error {
@@ -250,6 +323,8 @@ func (a *Activities) AcknowledgeTracksSuggestion(ctx context.Context, args Ackno
 
 type AcknowledgeTracksSuggestionFailArgs struct {
 	DeviceID string `json:"deviceID"`
+	// Tracks that have been refused, with the reason why
+	RejectedTracks []shared_mtv.RejectedTrack `json:"rejectedTracks"`
 }
 
 func (a *Activities) AcknowledgeTracksSuggestionFail(ctx context.Context, args AcknowledgeTracksSuggestionFailArgs) error {
@@ -361,3 +436,225 @@ func (a *Activities) AcknowledgeUpdateTimeConstraint(ctx context.Context, state
 
 	return err
 }
+
+func (a *Activities) AcknowledgeVoteToSkipCurrentTrack(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	requestBody := state
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-vote-to-skip-current-track"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+func (a *Activities) AcknowledgeUpdateQueueMode(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	requestBody := state
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-update-queue-mode"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+func (a *Activities) AcknowledgeSeek(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	requestBody := state
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-seek"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+func (a *Activities) AcknowledgeUpdateSettings(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	requestBody := state
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-update-settings"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+func (a *Activities) AcknowledgeTransferOwnership(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	requestBody := state
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-transfer-ownership"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type AcknowledgeKickUserArgs struct {
+	State          shared_mtv.MtvRoomExposedState `json:"state"`
+	KickedUserID   string                         `json:"kickedUserID"`
+	KickedDeviceID string                         `json:"kickedDeviceID"`
+	Banned         bool                           `json:"banned"`
+}
+
+func (a *Activities) AcknowledgeKickUserActivity(ctx context.Context, args AcknowledgeKickUserArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-kick-user"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type RejectJoinArgs struct {
+	RoomID   string `json:"roomID"`
+	UserID   string `json:"userID"`
+	DeviceID string `json:"deviceID"`
+}
+
+func (a *Activities) RejectJoinActivity(ctx context.Context, args RejectJoinArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/reject-join"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type AcknowledgeInviteUserArgs struct {
+	State          shared_mtv.MtvRoomExposedState `json:"state"`
+	InvitingUserID string                         `json:"invitingUserID"`
+	InvitedUserID  string                         `json:"invitedUserID"`
+}
+
+func (a *Activities) AcknowledgeInviteUserActivity(ctx context.Context, args AcknowledgeInviteUserArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-invite-user"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
+
+type AcknowledgeRevokeInvitationArgs struct {
+	State         shared_mtv.MtvRoomExposedState `json:"state"`
+	InvitedUserID string                         `json:"invitedUserID"`
+}
+
+func (a *Activities) AcknowledgeRevokeInvitationActivity(ctx context.Context, args AcknowledgeRevokeInvitationArgs) error {
+	requestBody := args
+
+	marshaledBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-revoke-invitation"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	_, err = client.Do(req)
+
+	return err
+}
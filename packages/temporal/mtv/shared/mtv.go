@@ -2,6 +2,7 @@ package shared_mtv
 
 import (
 	"errors"
+	"math"
 	"sort"
 	"time"
 
@@ -30,24 +31,32 @@ type MtvRoomTimer struct {
 const ControlTaskQueue = "CONTROL_TASK_QUEUE"
 
 var (
-	SignalChannelName            = "control"
-	MtvGetStateQuery             = "getState"
-	MtvGetUsersListQuery         = "getUsersList"
-	MtvGetRoomConstraintsDetails = "getRoomConstraintsDetails"
-	NoRelatedUserID              = ""
+	SignalChannelName             = "control"
+	MtvGetStateQuery              = "getState"
+	MtvGetUsersListQuery          = "getUsersList"
+	MtvGetRoomConstraintsDetails  = "getRoomConstraintsDetails"
+	MtvGetHistoryQuery            = "getHistory"
+	MtvGetPendingInvitationsQuery = "getPendingInvitations"
+	NoRelatedUserID               = ""
 )
 
 type TrackMetadataWithScore struct {
 	shared.TrackMetadata
 
 	Score int `json:"score"`
+
+	// Empty for tracks added by the autofill
+	AddedByUserID string    `json:"-"`
+	AddedAt       time.Time `json:"-"`
 }
 
 func (t TrackMetadataWithScore) WithMillisecondsDuration() TrackMetadataWithScoreWithDuration {
 	return TrackMetadataWithScoreWithDuration{
 		TrackMetadataWithScore: t,
 
-		Duration: t.Duration.Milliseconds(),
+		Duration:      t.Duration.Milliseconds(),
+		AddedByUserID: t.AddedByUserID,
+		AddedAt:       t.AddedAt.Format(time.RFC3339),
 	}
 }
 
@@ -117,6 +126,19 @@ func (s *TracksMetadataWithScoreSet) IncrementTrackScoreAndSortTracks(trackID st
 	return true
 }
 
+func (s *TracksMetadataWithScoreSet) DecrementTrackScoreAndSortTracks(trackID string) bool {
+	track, exists := s.Get(trackID)
+
+	if !exists {
+		return false
+	}
+
+	track.Score--
+	s.StableSortByHigherScore()
+
+	return true
+}
+
 func (s *TracksMetadataWithScoreSet) GetByIndex(index int) *TrackMetadataWithScore {
 	tracksLength := s.Len()
 
@@ -138,6 +160,33 @@ func (s *TracksMetadataWithScoreSet) FirstTrackIsReadyToBePlayed(minimumScoreToB
 	return firstTrack.Score >= minimumScoreToBePlayed
 }
 
+// ReadyToBePlayedLen returns how many tracks have a score high enough to be played.
+func (s *TracksMetadataWithScoreSet) ReadyToBePlayedLen(minimumScoreToBePlayed int) int {
+	readyToBePlayedLen := 0
+
+	for _, track := range s.tracks {
+		if track.Score >= minimumScoreToBePlayed {
+			readyToBePlayedLen++
+		}
+	}
+
+	return readyToBePlayedLen
+}
+
+// Requeue adds back an already played track to the set with the given score
+// and sorts the set. Among tracks having the same score it is placed last.
+func (s *TracksMetadataWithScoreSet) Requeue(track TrackMetadataWithScore, score int) bool {
+	track.Score = score
+
+	if added := s.Add(track); !added {
+		return false
+	}
+
+	s.StableSortByHigherScore()
+
+	return true
+}
+
 func (s *TracksMetadataWithScoreSet) StableSortByHigherScore() {
 	sort.SliceStable(s.tracks, func(i, j int) bool { return s.tracks[i].Score > s.tracks[j].Score })
 }
@@ -167,6 +216,28 @@ func (s *TracksMetadataWithScoreSet) Values() []TrackMetadataWithScore {
 	return s.tracks[:]
 }
 
+// ShiftAt removes the element at index from the set and returns it as well as true.
+// If there is no element at index, it returns an empty TrackMetadataWithScore and false.
+func (s *TracksMetadataWithScoreSet) ShiftAt(index int) (TrackMetadataWithScore, bool) {
+	if index == 0 {
+		return s.Shift()
+	}
+
+	if index < 0 {
+		return TrackMetadataWithScore{}, false
+	}
+
+	track := s.GetByIndex(index)
+	if track == nil {
+		return TrackMetadataWithScore{}, false
+	}
+
+	shiftedTrack := *track
+	s.Delete(shiftedTrack.ID)
+
+	return shiftedTrack, true
+}
+
 // Shift removes the first element from the set and returns it as well as true.
 // If the set was empty, it returns an empty TrackMetadataWithScore and false.
 func (s *TracksMetadataWithScoreSet) Shift() (TrackMetadataWithScore, bool) {
@@ -202,7 +273,10 @@ func (s TracksMetadataWithScoreSet) DeepEqual(toCmpTracksList TracksMetadataWith
 type TrackMetadataWithScoreWithDuration struct {
 	TrackMetadataWithScore
 
-	Duration int64 `json:"duration"`
+	Duration      int64  `json:"duration"`
+	AddedByUserID string `json:"addedByUserID"`
+	//Dates are stored using time.Time.Format()
+	AddedAt string `json:"addedAt"`
 }
 
 type CurrentTrack struct {
@@ -232,6 +306,157 @@ func (c CurrentTrack) Export(elapsed time.Duration) ExposedCurrentTrack {
 	}
 }
 
+// Only the most recent played tracks are kept, the history lives in the workflow
+// memory as long as the room does and the cooldown checks scan it on every suggestion.
+const PlayedTracksHistoryMaxLength = 100
+
+type PlayedTrack struct {
+	TrackMetadataWithScore
+
+	StartedAt        time.Time
+	EndedAt          time.Time
+	ListenedDuration time.Duration
+}
+
+type ExposedPlayedTrack struct {
+	TrackMetadataWithScoreWithDuration
+
+	//Dates are stored using time.Time.Format()
+	StartedAt string `json:"startedAt"`
+	Listened  int64  `json:"listened"`
+}
+
+func (t PlayedTrack) Export() ExposedPlayedTrack {
+	return ExposedPlayedTrack{
+		TrackMetadataWithScoreWithDuration: t.TrackMetadataWithScore.WithMillisecondsDuration(),
+
+		StartedAt: t.StartedAt.Format(time.RFC3339),
+		Listened:  t.ListenedDuration.Milliseconds(),
+	}
+}
+
+// PlayedTracksHistory stores played tracks in the order they have been played.
+type PlayedTracksHistory struct {
+	tracks []PlayedTrack
+}
+
+func (h *PlayedTracksHistory) Len() int {
+	return len(h.tracks)
+}
+
+// Add appends the track to the history and drops the oldest track
+// when the history is full.
+func (h *PlayedTracksHistory) Add(track PlayedTrack) {
+	h.tracks = append(h.tracks, track)
+
+	if overflow := len(h.tracks) - PlayedTracksHistoryMaxLength; overflow > 0 {
+		h.tracks = h.tracks[overflow:]
+	}
+}
+
+// HasBeenPlayedInLastTracks returns true if the track is one of the last count played tracks.
+func (h *PlayedTracksHistory) HasBeenPlayedInLastTracks(trackID string, count int) bool {
+	tracksLength := len(h.tracks)
+
+	for index := tracksLength - 1; index >= 0 && index >= tracksLength-count; index-- {
+		if h.tracks[index].ID == trackID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasBeenPlayedSince returns true if the track stopped being played after since.
+func (h *PlayedTracksHistory) HasBeenPlayedSince(trackID string, since time.Time) bool {
+	for index := len(h.tracks) - 1; index >= 0; index-- {
+		playedTrack := h.tracks[index]
+		if playedTrack.EndedAt.Before(since) {
+			return false
+		}
+
+		if playedTrack.ID == trackID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TracksIDs returns the distinct played tracks IDs, least recently played first.
+func (h *PlayedTracksHistory) TracksIDs() []string {
+	tracksIDs := make([]string, 0, len(h.tracks))
+	alreadyAdded := make(map[string]bool, len(h.tracks))
+
+	for index := len(h.tracks) - 1; index >= 0; index-- {
+		trackID := h.tracks[index].ID
+		if alreadyAdded[trackID] {
+			continue
+		}
+
+		alreadyAdded[trackID] = true
+		tracksIDs = append(tracksIDs, trackID)
+	}
+
+	for left, right := 0, len(tracksIDs)-1; left < right; left, right = left+1, right-1 {
+		tracksIDs[left], tracksIDs[right] = tracksIDs[right], tracksIDs[left]
+	}
+
+	return tracksIDs
+}
+
+// Page returns the requested page of the history, most recently played tracks first,
+// and whether there are more tracks after this page.
+// Pages start at 1.
+func (h *PlayedTracksHistory) Page(page int, limit int) ([]PlayedTrack, bool) {
+	indexes, hasMore := shared.MostRecentFirstPageIndexes(len(h.tracks), page, limit)
+
+	pageTracks := make([]PlayedTrack, 0, len(indexes))
+	for _, index := range indexes {
+		pageTracks = append(pageTracks, h.tracks[index])
+	}
+
+	return pageTracks, hasMore
+}
+
+type TrackRejectionReason string
+
+const (
+	TrackRejectionReasonRecentlyPlayed     TrackRejectionReason = "RECENTLY_PLAYED"
+	TrackRejectionReasonNotFound           TrackRejectionReason = TrackRejectionReason(shared.TrackFetchStatusNotFound)
+	TrackRejectionReasonUnplayable         TrackRejectionReason = TrackRejectionReason(shared.TrackFetchStatusUnplayable)
+	TrackRejectionReasonDurationParseError TrackRejectionReason = TrackRejectionReason(shared.TrackFetchStatusDurationParseError)
+	TrackRejectionReasonInvalidID          TrackRejectionReason = TrackRejectionReason(shared.TrackFetchStatusInvalidID)
+)
+
+type RejectedTrack struct {
+	TrackID string               `json:"trackID"`
+	Reason  TrackRejectionReason `json:"reason"`
+	//Tells why the provider can not play the track when rejected as unplayable
+	UnplayableReason string `json:"unplayableReason,omitempty"`
+}
+
+//Tracks whose metadata could not be fetched, with the fetch status as reason
+func RejectedTracksFromFetchResults(results []shared.TrackFetchResult) []RejectedTrack {
+	rejectedTracks := make([]RejectedTrack, 0)
+
+	for _, result := range shared.UnresolvedTrackFetchResults(results) {
+		rejectedTracks = append(rejectedTracks, RejectedTrack{
+			TrackID:          result.TrackID,
+			Reason:           TrackRejectionReason(result.Status),
+			UnplayableReason: result.UnplayableReason,
+		})
+	}
+
+	return rejectedTracks
+}
+
+type MtvRoomExposedHistory struct {
+	Tracks  []ExposedPlayedTrack `json:"tracks"`
+	Page    int                  `json:"page"`
+	HasMore bool                 `json:"hasMore"`
+}
+
 type InternalStateUser struct {
 	UserID                            string   `json:"userID"`
 	DeviceID                          string   `json:"emittingDeviceID"`
@@ -239,6 +464,8 @@ type InternalStateUser struct {
 	UserFitsPositionConstraint        *bool    `json:"userFitsPositionConstraint"`
 	HasControlAndDelegationPermission bool     `json:"hasControlAndDelegationPermission"`
 	UserHasBeenInvited                bool     `json:"userHasBeenInvited"`
+	HasVotedToSkipCurrentTrack        bool     `json:"hasVotedToSkipCurrentTrack"`
+	TracksDownvotedFor                []string `json:"tracksDownvotedFor,omitempty"`
 }
 
 type ExposedInternalStateUserListElement struct {
@@ -246,6 +473,12 @@ type ExposedInternalStateUserListElement struct {
 	HasControlAndDelegationPermission bool   `json:"hasControlAndDelegationPermission"`
 	IsCreator                         bool   `json:"isCreator"`
 	IsDelegationOwner                 bool   `json:"isDelegationOwner"`
+	IsOwner                           bool   `json:"isOwner"`
+}
+
+type MtvRoomInvitation struct {
+	InvitedUserID  string `json:"invitedUserID"`
+	InvitingUserID string `json:"invitingUserID"`
 }
 
 func (s *InternalStateUser) HasVotedFor(trackID string) bool {
@@ -257,6 +490,27 @@ func (s *InternalStateUser) HasVotedFor(trackID string) bool {
 	return false
 }
 
+// RemoveTrackVotedFor removes the given trackID from the user TracksVotedFor
+// It returns false if the user had not voted for the track.
+func (s *InternalStateUser) RemoveTrackVotedFor(trackID string) bool {
+	for index, votedFortrackID := range s.TracksVotedFor {
+		if votedFortrackID == trackID {
+			s.TracksVotedFor = append(s.TracksVotedFor[:index], s.TracksVotedFor[index+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *InternalStateUser) HasDownvotedFor(trackID string) bool {
+	for _, downvotedFortrackID := range s.TracksDownvotedFor {
+		if downvotedFortrackID == trackID {
+			return true
+		}
+	}
+	return false
+}
+
 type MtvRoomCoords struct {
 	Lat float32 `json:"lat" validate:"required"`
 	Lng float32 `json:"lng" validate:"required"`
@@ -298,6 +552,170 @@ const (
 
 var MtvPlayingModesAllValues = [...]MtvPlayingModes{MtvPlayingModeDirect, MtvPlayingModeBroadcast}
 
+// MtvQueueModes define how the next track is picked in the queue.
+type MtvQueueModes string
+
+func (m MtvQueueModes) IsValid() bool {
+	for _, mode := range MtvQueueModesAllValues {
+		if mode == m {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	// Tracks are played by score order
+	MtvQueueModeScore MtvQueueModes = "SCORE"
+	// Tracks are played randomly among the tracks ready to be played
+	MtvQueueModeShuffle MtvQueueModes = "SHUFFLE"
+	// Tracks are played by score order and requeued once played
+	MtvQueueModeLoop MtvQueueModes = "LOOP"
+)
+
+var MtvQueueModesAllValues = [...]MtvQueueModes{MtvQueueModeScore, MtvQueueModeShuffle, MtvQueueModeLoop}
+
+type MtvOwnershipTransferPolicies string
+
+func (p MtvOwnershipTransferPolicies) IsValid() bool {
+	for _, policy := range MtvOwnershipTransferPoliciesAllValues {
+		if policy == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	// Ownership goes to the member who joined the room first
+	MtvOwnershipTransferPolicyOldestMember MtvOwnershipTransferPolicies = "OLDEST_MEMBER"
+	// Ownership goes to the oldest member having control and delegation permission,
+	// or to the oldest member if none has it
+	MtvOwnershipTransferPolicyControlPermission MtvOwnershipTransferPolicies = "CONTROL_PERMISSION"
+)
+
+var MtvOwnershipTransferPoliciesAllValues = [...]MtvOwnershipTransferPolicies{MtvOwnershipTransferPolicyOldestMember, MtvOwnershipTransferPolicyControlPermission}
+
+type MtvVoteToSkipThresholdKinds string
+
+func (k MtvVoteToSkipThresholdKinds) IsValid() bool {
+	for _, kind := range MtvVoteToSkipThresholdKindsAllValues {
+		if kind == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	MtvVoteToSkipThresholdKindCount      MtvVoteToSkipThresholdKinds = "COUNT"
+	MtvVoteToSkipThresholdKindPercentage MtvVoteToSkipThresholdKinds = "PERCENTAGE"
+)
+
+var MtvVoteToSkipThresholdKindsAllValues = [...]MtvVoteToSkipThresholdKinds{MtvVoteToSkipThresholdKindCount, MtvVoteToSkipThresholdKindPercentage}
+
+type MtvRoomVoteToSkipThreshold struct {
+	Kind  MtvVoteToSkipThresholdKinds `json:"kind" validate:"required,oneof=COUNT PERCENTAGE"`
+	Value int                         `json:"value" validate:"min=1"`
+}
+
+// RequiredVotesCount returns how many skip votes are needed to skip the current track
+// in a room containing usersLength users.
+// A COUNT threshold higher than the users count is capped to it, otherwise
+// a room that lost some users could never skip a track again.
+func (t MtvRoomVoteToSkipThreshold) RequiredVotesCount(usersLength int) int {
+	var requiredVotesCount int
+
+	switch t.Kind {
+	case MtvVoteToSkipThresholdKindPercentage:
+		requiredVotesCount = int(math.Ceil(float64(usersLength*t.Value) / 100))
+	default:
+		requiredVotesCount = t.Value
+		if requiredVotesCount > usersLength {
+			requiredVotesCount = usersLength
+		}
+	}
+
+	if requiredVotesCount < 1 {
+		return 1
+	}
+
+	return requiredVotesCount
+}
+
+type MtvSuggestionCooldownKinds string
+
+func (k MtvSuggestionCooldownKinds) IsValid() bool {
+	for _, kind := range MtvSuggestionCooldownKindsAllValues {
+		if kind == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	MtvSuggestionCooldownKindTracksCount MtvSuggestionCooldownKinds = "TRACKS_COUNT"
+	MtvSuggestionCooldownKindDuration    MtvSuggestionCooldownKinds = "DURATION"
+)
+
+var MtvSuggestionCooldownKindsAllValues = [...]MtvSuggestionCooldownKinds{MtvSuggestionCooldownKindTracksCount, MtvSuggestionCooldownKindDuration}
+
+// MtvRoomSuggestionCooldown prevents a played track to be suggested again
+// before Value tracks have been played or Value seconds have passed.
+type MtvRoomSuggestionCooldown struct {
+	Kind  MtvSuggestionCooldownKinds `json:"kind" validate:"required,oneof=TRACKS_COUNT DURATION"`
+	Value int                        `json:"value" validate:"min=1"`
+}
+
+// IsCoolingDown returns true if the track has been played within the cooldown window.
+func (c MtvRoomSuggestionCooldown) IsCoolingDown(history *PlayedTracksHistory, trackID string, now time.Time) bool {
+	switch c.Kind {
+	case MtvSuggestionCooldownKindDuration:
+		windowStart := now.Add(-time.Duration(c.Value) * time.Second)
+
+		return history.HasBeenPlayedSince(trackID, windowStart)
+	default:
+		return history.HasBeenPlayedInLastTracks(trackID, c.Value)
+	}
+}
+
+type MtvAutofillSources string
+
+func (k MtvAutofillSources) IsValid() bool {
+	for _, source := range MtvAutofillSourcesAllValues {
+		if source == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	MtvAutofillSourceHistory    MtvAutofillSources = "HISTORY"
+	MtvAutofillSourcePlaylist   MtvAutofillSources = "PLAYLIST"
+	MtvAutofillSourceSeedTracks MtvAutofillSources = "SEED_TRACKS"
+)
+
+var MtvAutofillSourcesAllValues = [...]MtvAutofillSources{MtvAutofillSourceHistory, MtvAutofillSourcePlaylist, MtvAutofillSourceSeedTracks}
+
+// MtvRoomAutofill describes where the next track is picked from
+// when the current track ends and no track is ready to be played.
+// For PLAYLIST source SeedTracksIDs are filled with the exported mpe room tracks.
+type MtvRoomAutofill struct {
+	Source        MtvAutofillSources `json:"source" validate:"required,oneof=HISTORY PLAYLIST SEED_TRACKS"`
+	SeedTracksIDs []string           `json:"seedTracksIDs,omitempty"`
+}
+
+func (a MtvRoomAutofill) UsesSeedTracks() bool {
+	return a.Source == MtvAutofillSourcePlaylist || a.Source == MtvAutofillSourceSeedTracks
+}
+
 type MtvRoomCreationOptions struct {
 	RoomName               string `json:"name" validate:"required" mapstructure:"name"`
 	MinimumScoreToBePlayed int    `json:"minimumScoreToBePlayed" validate:"min=0"`
@@ -308,6 +726,19 @@ type MtvRoomCreationOptions struct {
 	HasPhysicalAndTimeConstraints bool                               `json:"hasPhysicalAndTimeConstraints"`
 	PhysicalAndTimeConstraints    *MtvRoomPhysicalAndTimeConstraints `json:"physicalAndTimeConstraints,omitempty"`
 	PlayingMode                   MtvPlayingModes                    `json:"playingMode" validate:"required,oneof=DIRECT BROADCAST"`
+	// A nil VoteToSkipThreshold disables the vote to skip feature
+	VoteToSkipThreshold *MtvRoomVoteToSkipThreshold `json:"voteToSkipThreshold,omitempty"`
+	// A queued track whose score drops below EvictionScoreFloor is removed from the queue
+	// A nil EvictionScoreFloor disables the eviction
+	EvictionScoreFloor *int `json:"evictionScoreFloor,omitempty"`
+	// A nil SuggestionCooldown lets users suggest a track right after it has been played
+	SuggestionCooldown *MtvRoomSuggestionCooldown `json:"suggestionCooldown,omitempty"`
+	// A nil Autofill lets the room be paused when the queue runs dry
+	Autofill *MtvRoomAutofill `json:"autofill,omitempty"`
+	// An empty QueueMode defaults to SCORE
+	QueueMode MtvQueueModes `json:"queueMode,omitempty"`
+	// Applied when the room owner leaves, an empty OwnershipTransferPolicy defaults to OLDEST_MEMBER
+	OwnershipTransferPolicy MtvOwnershipTransferPolicies `json:"ownershipTransferPolicy,omitempty"`
 }
 
 type MtvRoomCreationOptionsFromExportWithPlaceID struct {
@@ -320,6 +751,12 @@ type MtvRoomCreationOptionsFromExportWithPlaceID struct {
 	HasPhysicalAndTimeConstraints bool                                          `json:"hasPhysicalAndTimeConstraints"`
 	PhysicalAndTimeConstraints    *MtvRoomPhysicalAndTimeConstraintsWithPlaceID `json:"physicalAndTimeConstraints,omitempty"`
 	PlayingMode                   MtvPlayingModes                               `json:"playingMode" validate:"required,oneof=DIRECT BROADCAST"`
+	VoteToSkipThreshold           *MtvRoomVoteToSkipThreshold                   `json:"voteToSkipThreshold,omitempty"`
+	EvictionScoreFloor            *int                                          `json:"evictionScoreFloor,omitempty"`
+	SuggestionCooldown            *MtvRoomSuggestionCooldown                    `json:"suggestionCooldown,omitempty"`
+	Autofill                      *MtvRoomAutofill                              `json:"autofill,omitempty"`
+	QueueMode                     MtvQueueModes                                 `json:"queueMode,omitempty"`
+	OwnershipTransferPolicy       MtvOwnershipTransferPolicies                  `json:"ownershipTransferPolicy,omitempty"`
 }
 
 type MtvRoomParameters struct {
@@ -331,6 +768,30 @@ type MtvRoomParameters struct {
 	InitialTracksIDsList          []string
 }
 
+// MtvRoomSettings are the room parameters the creator can update while the room is running
+type MtvRoomSettings struct {
+	RoomName                      string                             `json:"name" validate:"required" mapstructure:"name"`
+	MinimumScoreToBePlayed        int                                `json:"minimumScoreToBePlayed" validate:"min=0"`
+	IsOpen                        bool                               `json:"isOpen"`
+	IsOpenOnlyInvitedUsersCanVote bool                               `json:"isOpenOnlyInvitedUsersCanVote"`
+	HasPhysicalAndTimeConstraints bool                               `json:"hasPhysicalAndTimeConstraints"`
+	PhysicalAndTimeConstraints    *MtvRoomPhysicalAndTimeConstraints `json:"physicalAndTimeConstraints,omitempty"`
+	PlayingMode                   MtvPlayingModes                    `json:"playingMode" validate:"required,oneof=DIRECT BROADCAST"`
+}
+
+// WithSettings returns a copy of the params in which the given settings replaced the current ones
+func (p MtvRoomParameters) WithSettings(settings MtvRoomSettings) MtvRoomParameters {
+	p.RoomName = settings.RoomName
+	p.MinimumScoreToBePlayed = settings.MinimumScoreToBePlayed
+	p.IsOpen = settings.IsOpen
+	p.IsOpenOnlyInvitedUsersCanVote = settings.IsOpenOnlyInvitedUsersCanVote
+	p.HasPhysicalAndTimeConstraints = settings.HasPhysicalAndTimeConstraints
+	p.PhysicalAndTimeConstraints = settings.PhysicalAndTimeConstraints
+	p.PlayingMode = settings.PlayingMode
+
+	return p
+}
+
 //This method will return an error if it determines that params are corrupted
 func (p MtvRoomParameters) CheckParamsValidity(now time.Time) error {
 	//Checking for unknown given playindMode label
@@ -349,6 +810,91 @@ func (p MtvRoomParameters) CheckParamsValidity(now time.Time) error {
 		return err
 	}
 
+	if err := p.VerifyVoteToSkipThreshold(); err != nil {
+		return err
+	}
+
+	if err := p.VerifySuggestionCooldown(); err != nil {
+		return err
+	}
+
+	if err := p.VerifyAutofill(); err != nil {
+		return err
+	}
+
+	queueModeIsInvalid := p.QueueMode != "" && !p.QueueMode.IsValid()
+	if queueModeIsInvalid {
+		return errors.New("QueueMode is invalid")
+	}
+
+	ownershipTransferPolicyIsInvalid := p.OwnershipTransferPolicy != "" && !p.OwnershipTransferPolicy.IsValid()
+	if ownershipTransferPolicyIsInvalid {
+		return errors.New("OwnershipTransferPolicy is invalid")
+	}
+
+	return nil
+}
+
+func (p MtvRoomParameters) VerifyAutofill() error {
+	autofillIsDisabled := p.Autofill == nil
+	if autofillIsDisabled {
+		return nil
+	}
+
+	if !p.Autofill.Source.IsValid() {
+		return errors.New("Autofill source is invalid")
+	}
+
+	seedTracksAreMissing := p.Autofill.UsesSeedTracks() && len(p.Autofill.SeedTracksIDs) == 0
+	if seedTracksAreMissing {
+		return errors.New("Autofill seed tracks are missing")
+	}
+
+	return nil
+}
+
+func (p MtvRoomParameters) VerifySuggestionCooldown() error {
+	suggestionCooldownIsDisabled := p.SuggestionCooldown == nil
+	if suggestionCooldownIsDisabled {
+		return nil
+	}
+
+	if !p.SuggestionCooldown.Kind.IsValid() {
+		return errors.New("SuggestionCooldown kind is invalid")
+	}
+
+	if p.SuggestionCooldown.Value < 1 {
+		return errors.New("SuggestionCooldown value must be greater than 0")
+	}
+
+	//Played tracks history does not remember more tracks
+	tracksCountIsGreaterThanHistoryLength := p.SuggestionCooldown.Kind == MtvSuggestionCooldownKindTracksCount && p.SuggestionCooldown.Value > PlayedTracksHistoryMaxLength
+	if tracksCountIsGreaterThanHistoryLength {
+		return errors.New("SuggestionCooldown tracks count is greater than played tracks history max length")
+	}
+
+	return nil
+}
+
+func (p MtvRoomParameters) VerifyVoteToSkipThreshold() error {
+	voteToSkipIsDisabled := p.VoteToSkipThreshold == nil
+	if voteToSkipIsDisabled {
+		return nil
+	}
+
+	if !p.VoteToSkipThreshold.Kind.IsValid() {
+		return errors.New("VoteToSkipThreshold kind is invalid")
+	}
+
+	if p.VoteToSkipThreshold.Value < 1 {
+		return errors.New("VoteToSkipThreshold value must be greater than 0")
+	}
+
+	percentageIsGreaterThanHundred := p.VoteToSkipThreshold.Kind == MtvVoteToSkipThresholdKindPercentage && p.VoteToSkipThreshold.Value > 100
+	if percentageIsGreaterThanHundred {
+		return errors.New("VoteToSkipThreshold percentage is greater than 100")
+	}
+
 	return nil
 }
 
@@ -419,6 +965,13 @@ type MtvRoomExposedState struct {
 	TimeConstraintIsValid             *bool                                `json:"timeConstraintIsValid"`
 	PlayingMode                       MtvPlayingModes                      `json:"playingMode"`
 	DelegationOwnerUserID             *string                              `json:"delegationOwnerUserID"`
+	SkipVotesCount                    int                                  `json:"skipVotesCount"`
+	// Nil when the vote to skip feature is disabled for the room
+	RequiredSkipVotesCount *int          `json:"requiredSkipVotesCount"`
+	EvictionScoreFloor     *int          `json:"evictionScoreFloor"`
+	QueueMode              MtvQueueModes `json:"queueMode"`
+	// The creator until the ownership is transferred
+	OwnerUserID string `json:"ownerUserID"`
 }
 
 const (
@@ -434,6 +987,17 @@ const (
 	SignalUpdateUserFitsPositionConstraint     shared.SignalRoute = "update-user-fits-position-constraint"
 	SignalUpdateDelegationOwner                shared.SignalRoute = "update-delegation-owner"
 	SignalUpdateControlAndDelegationPermission shared.SignalRoute = "update-control-and-delegation-permision"
+	SignalRouteVoteToSkipCurrentTrack          shared.SignalRoute = "vote-to-skip-current-track"
+	SignalRouteDownvoteTrack                   shared.SignalRoute = "downvote-track"
+	SignalRouteUnvoteForTrack                  shared.SignalRoute = "unvote-for-track"
+	SignalRouteUpdateQueueMode                 shared.SignalRoute = "update-queue-mode"
+	SignalRouteSeek                            shared.SignalRoute = "seek"
+	SignalRouteUpdateSettings                  shared.SignalRoute = "update-settings"
+	SignalRouteTransferOwnership               shared.SignalRoute = "transfer-ownership"
+	SignalRouteKickUser                        shared.SignalRoute = "kick-user"
+	SignalRouteBanUser                         shared.SignalRoute = "ban-user"
+	SignalRouteInviteUser                      shared.SignalRoute = "invite-user"
+	SignalRouteRevokeInvitation                shared.SignalRoute = "revoke-invitation"
 )
 
 type PlaySignal struct {
@@ -656,3 +1220,210 @@ func NewUpdateControlAndDelegationPermissionSignal(args NewUpdateControlAndDeleg
 		HasControlAndDelegationPermission: args.HasControlAndDelegationPermission,
 	}
 }
+
+type VoteToSkipCurrentTrackSignal struct {
+	Route  shared.SignalRoute `validate:"required"`
+	UserID string             `validate:"required,uuid"`
+}
+
+type NewVoteToSkipCurrentTrackSignalArgs struct {
+	UserID string `validate:"required,uuid"`
+}
+
+func NewVoteToSkipCurrentTrackSignal(args NewVoteToSkipCurrentTrackSignalArgs) VoteToSkipCurrentTrackSignal {
+	return VoteToSkipCurrentTrackSignal{
+		Route:  SignalRouteVoteToSkipCurrentTrack,
+		UserID: args.UserID,
+	}
+}
+
+type DownvoteTrackSignal struct {
+	Route   shared.SignalRoute `validate:"required"`
+	UserID  string             `validate:"required,uuid"`
+	TrackID string             `validate:"required"`
+}
+
+type NewDownvoteTrackSignalArgs struct {
+	UserID  string `validate:"required,uuid"`
+	TrackID string `validate:"required"`
+}
+
+func NewDownvoteTrackSignal(args NewDownvoteTrackSignalArgs) DownvoteTrackSignal {
+	return DownvoteTrackSignal{
+		Route:   SignalRouteDownvoteTrack,
+		TrackID: args.TrackID,
+		UserID:  args.UserID,
+	}
+}
+
+type UnvoteForTrackSignal struct {
+	Route   shared.SignalRoute `validate:"required"`
+	UserID  string             `validate:"required,uuid"`
+	TrackID string             `validate:"required"`
+}
+
+type NewUnvoteForTrackSignalArgs struct {
+	UserID  string `validate:"required,uuid"`
+	TrackID string `validate:"required"`
+}
+
+func NewUnvoteForTrackSignal(args NewUnvoteForTrackSignalArgs) UnvoteForTrackSignal {
+	return UnvoteForTrackSignal{
+		Route:   SignalRouteUnvoteForTrack,
+		TrackID: args.TrackID,
+		UserID:  args.UserID,
+	}
+}
+
+type UpdateQueueModeSignal struct {
+	Route     shared.SignalRoute `validate:"required"`
+	UserID    string             `validate:"required,uuid"`
+	QueueMode MtvQueueModes      `validate:"required,oneof=SCORE SHUFFLE LOOP"`
+}
+
+type NewUpdateQueueModeSignalArgs struct {
+	UserID    string        `validate:"required,uuid"`
+	QueueMode MtvQueueModes `validate:"required,oneof=SCORE SHUFFLE LOOP"`
+}
+
+func NewUpdateQueueModeSignal(args NewUpdateQueueModeSignalArgs) UpdateQueueModeSignal {
+	return UpdateQueueModeSignal{
+		Route:     SignalRouteUpdateQueueMode,
+		UserID:    args.UserID,
+		QueueMode: args.QueueMode,
+	}
+}
+
+type SeekSignal struct {
+	Route  shared.SignalRoute `validate:"required"`
+	UserID string             `validate:"required,uuid"`
+	// Position in the current track, in milliseconds
+	Position int64 `validate:"min=0"`
+}
+
+type NewSeekSignalArgs struct {
+	UserID   string `validate:"required,uuid"`
+	Position int64  `validate:"min=0"`
+}
+
+func NewSeekSignal(args NewSeekSignalArgs) SeekSignal {
+	return SeekSignal{
+		Route:    SignalRouteSeek,
+		UserID:   args.UserID,
+		Position: args.Position,
+	}
+}
+
+type UpdateSettingsSignal struct {
+	Route    shared.SignalRoute `validate:"required"`
+	UserID   string             `validate:"required,uuid"`
+	Settings MtvRoomSettings    `validate:"required"`
+}
+
+type NewUpdateSettingsSignalArgs struct {
+	UserID   string          `validate:"required,uuid"`
+	Settings MtvRoomSettings `validate:"required"`
+}
+
+func NewUpdateSettingsSignal(args NewUpdateSettingsSignalArgs) UpdateSettingsSignal {
+	return UpdateSettingsSignal{
+		Route:    SignalRouteUpdateSettings,
+		UserID:   args.UserID,
+		Settings: args.Settings,
+	}
+}
+
+type TransferOwnershipSignal struct {
+	Route          shared.SignalRoute `validate:"required"`
+	UserID         string             `validate:"required,uuid"`
+	NewOwnerUserID string             `validate:"required,uuid"`
+}
+
+type NewTransferOwnershipSignalArgs struct {
+	UserID         string `validate:"required,uuid"`
+	NewOwnerUserID string `validate:"required,uuid"`
+}
+
+func NewTransferOwnershipSignal(args NewTransferOwnershipSignalArgs) TransferOwnershipSignal {
+	return TransferOwnershipSignal{
+		Route:          SignalRouteTransferOwnership,
+		UserID:         args.UserID,
+		NewOwnerUserID: args.NewOwnerUserID,
+	}
+}
+
+type KickUserSignal struct {
+	Route        shared.SignalRoute `validate:"required"`
+	UserID       string             `validate:"required,uuid"`
+	KickedUserID string             `validate:"required,uuid"`
+}
+
+type NewKickUserSignalArgs struct {
+	UserID       string `validate:"required,uuid"`
+	KickedUserID string `validate:"required,uuid"`
+}
+
+func NewKickUserSignal(args NewKickUserSignalArgs) KickUserSignal {
+	return KickUserSignal{
+		Route:        SignalRouteKickUser,
+		UserID:       args.UserID,
+		KickedUserID: args.KickedUserID,
+	}
+}
+
+type BanUserSignal struct {
+	Route        shared.SignalRoute `validate:"required"`
+	UserID       string             `validate:"required,uuid"`
+	BannedUserID string             `validate:"required,uuid"`
+}
+
+type NewBanUserSignalArgs struct {
+	UserID       string `validate:"required,uuid"`
+	BannedUserID string `validate:"required,uuid"`
+}
+
+func NewBanUserSignal(args NewBanUserSignalArgs) BanUserSignal {
+	return BanUserSignal{
+		Route:        SignalRouteBanUser,
+		UserID:       args.UserID,
+		BannedUserID: args.BannedUserID,
+	}
+}
+
+type InviteUserSignal struct {
+	Route         shared.SignalRoute `validate:"required"`
+	UserID        string             `validate:"required,uuid"`
+	InvitedUserID string             `validate:"required,uuid"`
+}
+
+type NewInviteUserSignalArgs struct {
+	UserID        string `validate:"required,uuid"`
+	InvitedUserID string `validate:"required,uuid"`
+}
+
+func NewInviteUserSignal(args NewInviteUserSignalArgs) InviteUserSignal {
+	return InviteUserSignal{
+		Route:         SignalRouteInviteUser,
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
+
+type RevokeInvitationSignal struct {
+	Route         shared.SignalRoute `validate:"required"`
+	UserID        string             `validate:"required,uuid"`
+	InvitedUserID string             `validate:"required,uuid"`
+}
+
+type NewRevokeInvitationSignalArgs struct {
+	UserID        string `validate:"required,uuid"`
+	InvitedUserID string `validate:"required,uuid"`
+}
+
+func NewRevokeInvitationSignal(args NewRevokeInvitationSignalArgs) RevokeInvitationSignal {
+	return RevokeInvitationSignal{
+		Route:         SignalRouteRevokeInvitation,
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
@@ -2,6 +2,7 @@ package shared_mtv_test
 
 import (
 	"testing"
+	"time"
 
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	"github.com/AdonisEnProvence/MusicRoom/random"
@@ -82,6 +83,144 @@ func (s *UnitTestSuite) Test_TracksMetadataWithScoreSetAllowsDeletion() {
 	s.NotSame(&set.Values()[0], &clone.Values()[0])
 }
 
+func (s *UnitTestSuite) Test_VoteToSkipThresholdRequiredVotesCount() {
+	countThreshold := shared_mtv.MtvRoomVoteToSkipThreshold{
+		Kind:  shared_mtv.MtvVoteToSkipThresholdKindCount,
+		Value: 3,
+	}
+
+	s.Equal(3, countThreshold.RequiredVotesCount(10))
+	// Capped to the users count
+	s.Equal(2, countThreshold.RequiredVotesCount(2))
+
+	percentageThreshold := shared_mtv.MtvRoomVoteToSkipThreshold{
+		Kind:  shared_mtv.MtvVoteToSkipThresholdKindPercentage,
+		Value: 50,
+	}
+
+	s.Equal(5, percentageThreshold.RequiredVotesCount(10))
+	// Rounded up
+	s.Equal(2, percentageThreshold.RequiredVotesCount(3))
+	// At least one vote is always required
+	s.Equal(1, percentageThreshold.RequiredVotesCount(0))
+}
+
+func (s *UnitTestSuite) Test_PlayedTracksHistoryPagination() {
+	var history shared_mtv.PlayedTracksHistory
+
+	playedTracksIDs := make([]string, 0, shared_mtv.PlayedTracksHistoryMaxLength+2)
+	for index := 0; index < shared_mtv.PlayedTracksHistoryMaxLength+2; index++ {
+		playedTrack := shared_mtv.PlayedTrack{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         faker.UUIDHyphenated(),
+					Title:      faker.Word(),
+					ArtistName: faker.Name(),
+					Duration:   random.GenerateRandomDuration(),
+				},
+			},
+		}
+
+		history.Add(playedTrack)
+		playedTracksIDs = append(playedTracksIDs, playedTrack.ID)
+	}
+
+	// Oldest tracks are dropped
+	s.Equal(shared_mtv.PlayedTracksHistoryMaxLength, history.Len())
+
+	firstPage, hasMore := history.Page(1, 2)
+	s.True(hasMore)
+	s.Len(firstPage, 2)
+	// Most recently played first
+	s.Equal(playedTracksIDs[len(playedTracksIDs)-1], firstPage[0].ID)
+	s.Equal(playedTracksIDs[len(playedTracksIDs)-2], firstPage[1].ID)
+
+	lastPage, hasMore := history.Page(shared_mtv.PlayedTracksHistoryMaxLength/2, 2)
+	s.False(hasMore)
+	s.Len(lastPage, 2)
+	s.Equal(playedTracksIDs[2], lastPage[1].ID)
+
+	outOfRangePage, hasMore := history.Page(shared_mtv.PlayedTracksHistoryMaxLength, 2)
+	s.False(hasMore)
+	s.Empty(outOfRangePage)
+
+	invalidPage, hasMore := history.Page(0, 2)
+	s.False(hasMore)
+	s.Empty(invalidPage)
+}
+
+func (s *UnitTestSuite) Test_SuggestionCooldownIsCoolingDown() {
+	var (
+		history  shared_mtv.PlayedTracksHistory
+		now      = time.Now()
+		tracksID = []string{faker.UUIDHyphenated(), faker.UUIDHyphenated()}
+	)
+
+	history.Add(shared_mtv.PlayedTrack{
+		TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+			TrackMetadata: shared.TrackMetadata{ID: tracksID[0]},
+		},
+		EndedAt: now.Add(-10 * time.Minute),
+	})
+	history.Add(shared_mtv.PlayedTrack{
+		TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+			TrackMetadata: shared.TrackMetadata{ID: tracksID[1]},
+		},
+		EndedAt: now.Add(-time.Minute),
+	})
+
+	tracksCountCooldown := shared_mtv.MtvRoomSuggestionCooldown{
+		Kind:  shared_mtv.MtvSuggestionCooldownKindTracksCount,
+		Value: 1,
+	}
+
+	s.False(tracksCountCooldown.IsCoolingDown(&history, tracksID[0], now))
+	s.True(tracksCountCooldown.IsCoolingDown(&history, tracksID[1], now))
+
+	durationCooldown := shared_mtv.MtvRoomSuggestionCooldown{
+		Kind:  shared_mtv.MtvSuggestionCooldownKindDuration,
+		Value: int((5 * time.Minute).Seconds()),
+	}
+
+	s.False(durationCooldown.IsCoolingDown(&history, tracksID[0], now))
+	s.True(durationCooldown.IsCoolingDown(&history, tracksID[1], now))
+	s.False(durationCooldown.IsCoolingDown(&history, faker.UUIDHyphenated(), now))
+}
+
+func (s *UnitTestSuite) Test_PlayedTracksHistoryTracksIDs() {
+	var (
+		history  shared_mtv.PlayedTracksHistory
+		tracksID = []string{faker.UUIDHyphenated(), faker.UUIDHyphenated(), faker.UUIDHyphenated()}
+	)
+
+	for _, trackID := range []string{tracksID[0], tracksID[1], tracksID[0], tracksID[2]} {
+		history.Add(shared_mtv.PlayedTrack{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{ID: trackID},
+			},
+		})
+	}
+
+	// Distinct tracks, least recently played first
+	s.Equal([]string{tracksID[1], tracksID[0], tracksID[2]}, history.TracksIDs())
+}
+
+func (s *UnitTestSuite) Test_RejectedTracksFromFetchResults() {
+	tracksID := []string{faker.UUIDHyphenated(), faker.UUIDHyphenated(), faker.UUIDHyphenated()}
+
+	rejectedTracks := shared_mtv.RejectedTracksFromFetchResults([]shared.TrackFetchResult{
+		{TrackID: tracksID[0], Status: shared.TrackFetchStatusFound},
+		{TrackID: tracksID[1], Status: shared.TrackFetchStatusUnplayable, UnplayableReason: "LIVE_BROADCAST"},
+		{TrackID: tracksID[2], Status: shared.TrackFetchStatusNotFound},
+	})
+
+	// The reason given by the provider is kept for unplayable tracks
+	s.Equal([]shared_mtv.RejectedTrack{
+		{TrackID: tracksID[1], Reason: shared_mtv.TrackRejectionReasonUnplayable, UnplayableReason: "LIVE_BROADCAST"},
+		{TrackID: tracksID[2], Reason: shared_mtv.TrackRejectionReasonNotFound},
+	}, rejectedTracks)
+}
+
 func TestUnitTestSuite(t *testing.T) {
 	suite.Run(t, new(UnitTestSuite))
 }
@@ -3,11 +3,13 @@ package mtv
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/AdonisEnProvence/MusicRoom/activities"
 	activities_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/activities"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/providers"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
 	"github.com/Devessier/brainy"
 
@@ -32,15 +34,36 @@ type MtvRoomInternalState struct {
 	CurrentTrackCheckForVoteUpdateLastSave shared_mtv.CurrentTrack
 	timeConstraintIsValid                  *bool
 	DelegationOwnerUserID                  *string
+	CurrentTrackStartedAt                  time.Time
+	PlayedTracks                           shared_mtv.PlayedTracksHistory
+	autofillSeedTracksCursor               int
+	autofillFailedTracksIDs                map[string]bool
+	QueueMode                              shared_mtv.MtvQueueModes
+	OwnerUserID                            string
+	usersJoiningOrder                      []string
+	bannedUsersIDs                         []string
+	invitations                            []shared_mtv.MtvRoomInvitation
+	//Sum of the jumps made by seeking in the current track, negative when
+	//seeking backward, so that the listened duration can be told from the position
+	currentTrackSeekOffset time.Duration
 }
 
 //This method will merge given params in the internalState
 func (s *MtvRoomInternalState) FillWith(params shared_mtv.MtvRoomParameters) {
 	s.initialParams = params
 	s.Users = make(map[string]*shared_mtv.InternalStateUser)
+	s.usersJoiningOrder = make([]string, 0)
+	s.bannedUsersIDs = make([]string, 0)
+	s.invitations = make([]shared_mtv.MtvRoomInvitation, 0)
 	s.AddUser(*params.CreatorUserRelatedInformation)
+	s.OwnerUserID = params.RoomCreatorUserID
 	s.DelegationOwnerUserID = nil
 	s.timeConstraintIsValid = nil
+	s.QueueMode = params.QueueMode
+
+	if s.QueueMode == "" {
+		s.QueueMode = shared_mtv.MtvQueueModeScore
+	}
 
 	if params.PlayingMode == shared_mtv.MtvPlayingModeDirect {
 		s.DelegationOwnerUserID = &params.RoomCreatorUserID
@@ -51,6 +74,35 @@ func (s *MtvRoomInternalState) FillWith(params shared_mtv.MtvRoomParameters) {
 	}
 }
 
+//This method will apply the given settings updated by the room creator
+//Time constraint status is managed by the caller that re-arms the constraint timers
+func (s *MtvRoomInternalState) UpdateSettings(settings shared_mtv.MtvRoomSettings) {
+	previousPlayingMode := s.initialParams.PlayingMode
+	s.initialParams = s.initialParams.WithSettings(settings)
+
+	playingModeHasChanged := previousPlayingMode != settings.PlayingMode
+	if playingModeHasChanged {
+		s.DelegationOwnerUserID = nil
+
+		if settings.PlayingMode == shared_mtv.MtvPlayingModeDirect {
+			ownerUserID := s.OwnerUserID
+			s.DelegationOwnerUserID = &ownerUserID
+		}
+	}
+
+	for _, user := range s.Users {
+		if !settings.HasPhysicalAndTimeConstraints {
+			user.UserFitsPositionConstraint = nil
+			continue
+		}
+
+		if user.UserFitsPositionConstraint == nil {
+			tmp := false
+			user.UserFitsPositionConstraint = &tmp
+		}
+	}
+}
+
 // In the internalState.Export method we do not use workflow.sideEffect for at least two reasons:
 // 1- we cannot use workflow.sideEffect in the getState queryHandler
 // 2- we never update our internalState depending on internalState.Export() results this data aims to be sent to adonis.
@@ -93,15 +145,142 @@ func (s *MtvRoomInternalState) Export(RelatedUserID string) shared_mtv.MtvRoomEx
 		IsOpen:                            s.initialParams.IsOpen,
 		IsOpenOnlyInvitedUsersCanVotes:    s.initialParams.IsOpenOnlyInvitedUsersCanVote,
 		DelegationOwnerUserID:             s.DelegationOwnerUserID,
+		SkipVotesCount:                    s.CountSkipVotes(),
+		EvictionScoreFloor:                s.initialParams.EvictionScoreFloor,
+		QueueMode:                         s.QueueMode,
+		OwnerUserID:                       s.OwnerUserID,
+	}
+
+	if s.initialParams.VoteToSkipThreshold != nil {
+		requiredSkipVotesCount := s.initialParams.VoteToSkipThreshold.RequiredVotesCount(len(s.Users))
+		exposedState.RequiredSkipVotesCount = &requiredSkipVotesCount
 	}
 
 	return exposedState
 }
 
+func (s *MtvRoomInternalState) ExportHistory(page int, limit int) shared_mtv.MtvRoomExposedHistory {
+	playedTracks, hasMore := s.PlayedTracks.Page(page, limit)
+
+	exposedPlayedTracks := make([]shared_mtv.ExposedPlayedTrack, 0, len(playedTracks))
+	for _, playedTrack := range playedTracks {
+		exposedPlayedTracks = append(exposedPlayedTracks, playedTrack.Export())
+	}
+
+	return shared_mtv.MtvRoomExposedHistory{
+		Tracks:  exposedPlayedTracks,
+		Page:    page,
+		HasMore: hasMore,
+	}
+}
+
+func (s *MtvRoomInternalState) autofillCandidatesTracksIDs() []string {
+	autofill := s.initialParams.Autofill
+	if !autofill.UsesSeedTracks() {
+		return s.PlayedTracks.TracksIDs()
+	}
+
+	//Seed tracks are picked in turn, starting after the last picked one
+	seedTracksIDs := autofill.SeedTracksIDs
+	cursor := s.autofillSeedTracksCursor % len(seedTracksIDs)
+	candidates := make([]string, 0, len(seedTracksIDs))
+	candidates = append(candidates, seedTracksIDs[cursor:]...)
+	candidates = append(candidates, seedTracksIDs[:cursor]...)
+
+	return candidates
+}
+
+// PickAutofillTrackID returns the ID of the track to play when the queue runs dry.
+// Tracks in the queue and tracks whose fetching failed are never picked.
+// Tracks in suggestion cooldown are only picked if there is no other choice,
+// and the track that just ended is picked as a last resort.
+func (s *MtvRoomInternalState) PickAutofillTrackID(now time.Time) (string, bool) {
+	autofill := s.initialParams.Autofill
+	if autofill == nil {
+		return "", false
+	}
+
+	candidates := s.autofillCandidatesTracksIDs()
+	suggestionCooldown := s.initialParams.SuggestionCooldown
+
+	passes := []struct {
+		ignoreCooldown    bool
+		allowCurrentTrack bool
+	}{
+		{ignoreCooldown: false, allowCurrentTrack: false},
+		{ignoreCooldown: true, allowCurrentTrack: false},
+		{ignoreCooldown: true, allowCurrentTrack: true},
+	}
+
+	for _, pass := range passes {
+		for _, trackID := range candidates {
+			isCurrentTrack := s.CurrentTrack.ID == trackID
+			isInQueue := s.Tracks.Has(trackID)
+			hasFailed := s.autofillFailedTracksIDs[trackID]
+			if (isCurrentTrack && !pass.allowCurrentTrack) || isInQueue || hasFailed {
+				continue
+			}
+
+			isCoolingDown := suggestionCooldown != nil && suggestionCooldown.IsCoolingDown(&s.PlayedTracks, trackID, now)
+			if isCoolingDown && !pass.ignoreCooldown {
+				continue
+			}
+
+			if autofill.UsesSeedTracks() {
+				for index, seedTrackID := range autofill.SeedTracksIDs {
+					if seedTrackID == trackID {
+						s.autofillSeedTracksCursor = (index + 1) % len(autofill.SeedTracksIDs)
+						break
+					}
+				}
+			}
+
+			return trackID, true
+		}
+	}
+
+	return "", false
+}
+
+// MarkAutofillTrackAsFailed prevents the track from being picked again
+// until the autofill is restarted by the end of another track.
+func (s *MtvRoomInternalState) MarkAutofillTrackAsFailed(trackID string) {
+	if s.autofillFailedTracksIDs == nil {
+		s.autofillFailedTracksIDs = make(map[string]bool)
+	}
+
+	s.autofillFailedTracksIDs[trackID] = true
+}
+
+func (s *MtvRoomInternalState) ResetAutofillFailedTracks() {
+	s.autofillFailedTracksIDs = nil
+}
+
+// AddAutofillTrack adds the track to the queue with just enough score to be played.
+func (s *MtvRoomInternalState) AddAutofillTrack(track shared.TrackMetadata, addedAt time.Time) bool {
+	added := s.Tracks.Add(shared_mtv.TrackMetadataWithScore{
+		TrackMetadata: track,
+
+		Score:   s.initialParams.MinimumScoreToBePlayed,
+		AddedAt: addedAt,
+	})
+	if !added {
+		return false
+	}
+
+	s.Tracks.StableSortByHigherScore()
+
+	return true
+}
+
 func (s *MtvRoomInternalState) AddUser(user shared_mtv.InternalStateUser) {
 	//Do not override user if already exist
 	if _, ok := s.Users[user.UserID]; !ok {
+		//Invitations sent from the workflow are merged with the one given by adonis
+		user.UserHasBeenInvited = user.UserHasBeenInvited || s.UserHasBeenInvited(user.UserID)
+
 		s.Users[user.UserID] = &user
+		s.usersJoiningOrder = append(s.usersJoiningOrder, user.UserID)
 	} else {
 		fmt.Printf("\n User %s already existing in s.Users\n", user.UserID)
 	}
@@ -122,32 +301,213 @@ func (s *MtvRoomInternalState) RemoveTrackFromUserTracksVotedFor(trackID string)
 	}
 }
 
+func (s *MtvRoomInternalState) RemoveTrackFromUserTracksDownvotedFor(trackID string) {
+	for _, user := range s.Users {
+		lastTracksDownvotedForElementIndex := len(user.TracksDownvotedFor) - 1
+
+		for index, trackDownvotedForID := range user.TracksDownvotedFor {
+			if trackDownvotedForID == trackID {
+				//remove element from slice
+				user.TracksDownvotedFor[index] = user.TracksDownvotedFor[lastTracksDownvotedForElementIndex]
+				user.TracksDownvotedFor = user.TracksDownvotedFor[:lastTracksDownvotedForElementIndex]
+				break
+			}
+		}
+	}
+}
+
 func (s *MtvRoomInternalState) RemoveUser(userID string) bool {
 	if _, ok := s.Users[userID]; ok {
 		delete(s.Users, userID)
+
+		for index, joinedUserID := range s.usersJoiningOrder {
+			if joinedUserID == userID {
+				s.usersJoiningOrder = append(s.usersJoiningOrder[:index], s.usersJoiningOrder[index+1:]...)
+				break
+			}
+		}
+
 		return true
 	}
 	fmt.Printf("\n Couldnt find User %s \n", userID)
 	return false
 }
 
-func (s *MtvRoomInternalState) UpdateUserFitsPositionConstraint(userID string, userFitsPositionConstraint bool) bool {
-	if user, ok := s.Users[userID]; ok {
-		user.UserFitsPositionConstraint = &userFitsPositionConstraint
+// RemoveUserAndReassignRoles removes the user from the room,
+// the ownership and the delegation are given to other members if the user was holding them.
+func (s *MtvRoomInternalState) RemoveUserAndReassignRoles(userID string) bool {
+	if success := s.RemoveUser(userID); !success {
+		return false
+	}
+
+	//A room without owner could not be administered anymore
+	ownerIsLeavingRoom := s.OwnerUserID == userID
+	if ownerIsLeavingRoom {
+		if nextOwnerUserID, found := s.PickNextOwnerUserID(); found {
+			s.TransferOwnership(nextOwnerUserID)
+		}
+	}
+
+	roomIsInDirectMode := s.initialParams.PlayingMode == shared_mtv.MtvPlayingModeDirect
+	delegationOwnerIsLeavingRoom := s.DelegationOwnerUserID != nil && *s.DelegationOwnerUserID == userID
+	if delegationOwnerIsLeavingRoom && roomIsInDirectMode {
+		ownerUserID := s.OwnerUserID
+		s.DelegationOwnerUserID = &ownerUserID
+	}
+
+	return true
+}
+
+// PurgeUserVotes cancels the votes and downvotes the user gave to the queued tracks.
+func (s *MtvRoomInternalState) PurgeUserVotes(userID string) {
+	user, exists := s.Users[userID]
+	if !exists {
+		return
+	}
+
+	for _, trackID := range user.TracksVotedFor {
+		s.Tracks.DecrementTrackScoreAndSortTracks(trackID)
+	}
+	user.TracksVotedFor = make([]string, 0)
+
+	for _, trackID := range user.TracksDownvotedFor {
+		s.Tracks.IncrementTrackScoreAndSortTracks(trackID)
+	}
+	user.TracksDownvotedFor = nil
+}
+
+func (s *MtvRoomInternalState) BanUser(userID string) {
+	if s.UserIsBanned(userID) {
+		return
+	}
+
+	s.bannedUsersIDs = append(s.bannedUsersIDs, userID)
+}
+
+func (s *MtvRoomInternalState) UserIsBanned(userID string) bool {
+	for _, bannedUserID := range s.bannedUsersIDs {
+		if bannedUserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *MtvRoomInternalState) UserHasBeenInvited(userID string) bool {
+	for _, invitation := range s.invitations {
+		if invitation.InvitedUserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InviteUser records the invitation, if the invited user is already
+// in the room he immediately gets the invited users privileges.
+func (s *MtvRoomInternalState) InviteUser(invitingUserID string, invitedUserID string) bool {
+	if s.UserHasBeenInvited(invitedUserID) {
+		return false
+	}
+
+	s.invitations = append(s.invitations, shared_mtv.MtvRoomInvitation{
+		InvitedUserID:  invitedUserID,
+		InvitingUserID: invitingUserID,
+	})
+
+	if user, exists := s.Users[invitedUserID]; exists {
+		user.UserHasBeenInvited = true
+	}
+
+	return true
+}
+
+func (s *MtvRoomInternalState) RevokeInvitation(invitedUserID string) bool {
+	for index, invitation := range s.invitations {
+		if invitation.InvitedUserID != invitedUserID {
+			continue
+		}
+
+		s.invitations = append(s.invitations[:index], s.invitations[index+1:]...)
+
+		if user, exists := s.Users[invitedUserID]; exists {
+			user.UserHasBeenInvited = false
+		}
+
 		return true
 	}
-	fmt.Printf("\n Couldnt find User %s \n", userID)
+
 	return false
 }
 
-func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) bool {
+// PendingInvitations returns the invitations of the users who have not joined the room yet,
+// in the order they have been sent.
+func (s *MtvRoomInternalState) PendingInvitations() []shared_mtv.MtvRoomInvitation {
+	pendingInvitations := make([]shared_mtv.MtvRoomInvitation, 0, len(s.invitations))
 
-	user, exists := s.Users[userID]
-	if !exists {
-		fmt.Println("vote aborted: couldnt find given userID in the users list")
+	for _, invitation := range s.invitations {
+		if s.HasUser(invitation.InvitedUserID) {
+			continue
+		}
+
+		pendingInvitations = append(pendingInvitations, invitation)
+	}
+
+	return pendingInvitations
+}
+
+// PickNextOwnerUserID returns the member who should own the room according to the ownership transfer policy.
+// Members are browsed by joining order, the current owner is never picked.
+func (s *MtvRoomInternalState) PickNextOwnerUserID() (string, bool) {
+	candidatesUserIDs := make([]string, 0, len(s.usersJoiningOrder))
+	for _, userID := range s.usersJoiningOrder {
+		if userID != s.OwnerUserID {
+			candidatesUserIDs = append(candidatesUserIDs, userID)
+		}
+	}
+
+	if len(candidatesUserIDs) == 0 {
+		return "", false
+	}
+
+	if s.initialParams.OwnershipTransferPolicy == shared_mtv.MtvOwnershipTransferPolicyControlPermission {
+		for _, userID := range candidatesUserIDs {
+			if s.UserHasControlAndDelegationPermission(userID) {
+				return userID, true
+			}
+		}
+	}
+
+	return candidatesUserIDs[0], true
+}
+
+// TransferOwnership makes the given member the room owner.
+// The owner is always granted the control and delegation permission.
+func (s *MtvRoomInternalState) TransferOwnership(newOwnerUserID string) bool {
+	newOwner := s.GetUserRelatedInformation(newOwnerUserID)
+	if newOwner == nil {
 		return false
 	}
 
+	s.OwnerUserID = newOwnerUserID
+	newOwner.HasControlAndDelegationPermission = true
+
+	return true
+}
+
+func (s *MtvRoomInternalState) UpdateUserFitsPositionConstraint(userID string, userFitsPositionConstraint bool) bool {
+	if user, ok := s.Users[userID]; ok {
+		user.UserFitsPositionConstraint = &userFitsPositionConstraint
+		return true
+	}
+	fmt.Printf("\n Couldnt find User %s \n", userID)
+	return false
+}
+
+// userFitsVotingRestrictions checks the room constraints and the invited users rule
+// that apply to every kind of vote.
+func (s *MtvRoomInternalState) userFitsVotingRestrictions(user *shared_mtv.InternalStateUser) bool {
 	if s.initialParams.HasPhysicalAndTimeConstraints {
 		timeConstraintIsNotValid := s.timeConstraintIsValid == nil || !*(s.timeConstraintIsValid)
 		userPositionConstraintIsNotValid := user.UserFitsPositionConstraint == nil || !*(user.UserFitsPositionConstraint)
@@ -160,16 +520,31 @@ func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) b
 
 	roomIsOpenAndOnlyInvitedUsersCanVote := s.initialParams.IsOpen && s.initialParams.IsOpenOnlyInvitedUsersCanVote
 	if roomIsOpenAndOnlyInvitedUsersCanVote {
-		userIsNotRoomCreator := user.UserID != s.initialParams.RoomCreatorUserID
+		userIsNotRoomOwner := user.UserID != s.OwnerUserID
 		userHasNotBeenInvited := !user.UserHasBeenInvited
 
-		userIsNeitherInvitedOrCreator := userIsNotRoomCreator && userHasNotBeenInvited
-		if userIsNeitherInvitedOrCreator {
+		userIsNeitherInvitedOrOwner := userIsNotRoomOwner && userHasNotBeenInvited
+		if userIsNeitherInvitedOrOwner {
 			fmt.Println("vote aborted: room is open and only invited users can vote, voting user has not been invited")
 			return false
 		}
 	}
 
+	return true
+}
+
+func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) bool {
+
+	user, exists := s.Users[userID]
+	if !exists {
+		fmt.Println("vote aborted: couldnt find given userID in the users list")
+		return false
+	}
+
+	if !s.userFitsVotingRestrictions(user) {
+		return false
+	}
+
 	couldFindTrackInTracksList := s.Tracks.Has(trackID)
 	if !couldFindTrackInTracksList {
 		fmt.Println("vote aborted: couldnt find given trackID in the tracks list")
@@ -182,6 +557,12 @@ func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) b
 		return false
 	}
 
+	userAlreadyDownvotedTrack := user.HasDownvotedFor(trackID)
+	if userAlreadyDownvotedTrack {
+		fmt.Println("vote aborted: given userID has already downvoted given trackID")
+		return false
+	}
+
 	user.TracksVotedFor = append(user.TracksVotedFor, trackID)
 
 	s.Tracks.IncrementTrackScoreAndSortTracks(trackID)
@@ -189,6 +570,152 @@ func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) b
 	return true
 }
 
+func (s *MtvRoomInternalState) UserUnvoteForTrack(userID string, trackID string) bool {
+
+	user, exists := s.Users[userID]
+	if !exists {
+		return false
+	}
+
+	couldFindTrackInTracksList := s.Tracks.Has(trackID)
+	if !couldFindTrackInTracksList {
+		return false
+	}
+
+	userHasVotedForTrack := user.RemoveTrackVotedFor(trackID)
+	if !userHasVotedForTrack {
+		return false
+	}
+
+	s.Tracks.DecrementTrackScoreAndSortTracks(trackID)
+
+	return true
+}
+
+func (s *MtvRoomInternalState) UserDownvoteTrack(userID string, trackID string) bool {
+
+	user, exists := s.Users[userID]
+	if !exists {
+		return false
+	}
+
+	if !s.userFitsVotingRestrictions(user) {
+		return false
+	}
+
+	couldFindTrackInTracksList := s.Tracks.Has(trackID)
+	if !couldFindTrackInTracksList {
+		return false
+	}
+
+	userAlreadyDownvotedTrack := user.HasDownvotedFor(trackID)
+	if userAlreadyDownvotedTrack {
+		return false
+	}
+
+	userAlreadyVotedForTrack := user.HasVotedFor(trackID)
+	if userAlreadyVotedForTrack {
+		return false
+	}
+
+	user.TracksDownvotedFor = append(user.TracksDownvotedFor, trackID)
+
+	s.Tracks.DecrementTrackScoreAndSortTracks(trackID)
+
+	return true
+}
+
+// EvictTrackIfScoreIsBelowFloor removes the given track from the queue
+// if the room has an eviction score floor and the track score dropped below it.
+// It returns true if the track has been evicted.
+func (s *MtvRoomInternalState) EvictTrackIfScoreIsBelowFloor(trackID string) bool {
+	evictionIsDisabled := s.initialParams.EvictionScoreFloor == nil
+	if evictionIsDisabled {
+		return false
+	}
+
+	track, exists := s.Tracks.Get(trackID)
+	if !exists {
+		return false
+	}
+
+	scoreIsNotBelowFloor := track.Score >= *s.initialParams.EvictionScoreFloor
+	if scoreIsNotBelowFloor {
+		return false
+	}
+
+	s.Tracks.Delete(trackID)
+	s.RemoveTrackFromUserTracksVotedFor(trackID)
+	s.RemoveTrackFromUserTracksDownvotedFor(trackID)
+
+	return true
+}
+
+func (s *MtvRoomInternalState) UserCanVoteToSkipCurrentTrack(userID string) bool {
+	voteToSkipIsDisabled := s.initialParams.VoteToSkipThreshold == nil
+	if voteToSkipIsDisabled {
+		return false
+	}
+
+	user, exists := s.Users[userID]
+	if !exists {
+		return false
+	}
+
+	noCurrentTrack := s.CurrentTrack.ID == ""
+	if noCurrentTrack {
+		return false
+	}
+
+	if user.HasVotedToSkipCurrentTrack {
+		return false
+	}
+
+	return s.userFitsVotingRestrictions(user)
+}
+
+func (s *MtvRoomInternalState) UserVoteToSkipCurrentTrack(userID string) bool {
+	if !s.UserCanVoteToSkipCurrentTrack(userID) {
+		return false
+	}
+
+	s.Users[userID].HasVotedToSkipCurrentTrack = true
+
+	return true
+}
+
+func (s *MtvRoomInternalState) CountSkipVotes() int {
+	skipVotesCount := 0
+
+	for _, user := range s.Users {
+		if user.HasVotedToSkipCurrentTrack {
+			skipVotesCount++
+		}
+	}
+
+	return skipVotesCount
+}
+
+//Votes are counted again when the next track is not ready yet
+//or when users leave the room, as the threshold can be reached later
+func (s *MtvRoomInternalState) SkipVotesReachThreshold() bool {
+	voteToSkipIsDisabled := s.initialParams.VoteToSkipThreshold == nil
+	if voteToSkipIsDisabled || s.CurrentTrack.ID == "" {
+		return false
+	}
+
+	skipVotesCount := s.CountSkipVotes()
+	requiredSkipVotesCount := s.initialParams.VoteToSkipThreshold.RequiredVotesCount(len(s.Users))
+
+	return skipVotesCount > 0 && skipVotesCount >= requiredSkipVotesCount
+}
+
+func (s *MtvRoomInternalState) ResetSkipVotes() {
+	for _, user := range s.Users {
+		user.HasVotedToSkipCurrentTrack = false
+	}
+}
+
 func (s *MtvRoomInternalState) UpdateUserDeviceID(user shared_mtv.InternalStateUser) {
 	if val, ok := s.Users[user.UserID]; ok {
 		val.DeviceID = user.DeviceID
@@ -214,6 +741,16 @@ func (s *MtvRoomInternalState) UserHasControlAndDelegationPermission(userID stri
 	return user.HasControlAndDelegationPermission
 }
 
+// HasNextTrackToPlay returns true if a track can be loaded as the next current track.
+// In LOOP queue mode the current track is requeued, it can therefore always be played again.
+func (s *MtvRoomInternalState) HasNextTrackToPlay() bool {
+	if s.Tracks.FirstTrackIsReadyToBePlayed(s.initialParams.MinimumScoreToBePlayed) {
+		return true
+	}
+
+	return s.QueueMode == shared_mtv.MtvQueueModeLoop && s.CurrentTrack.ID != ""
+}
+
 func (s *MtvRoomInternalState) HasUser(userID string) bool {
 	_, exists := s.Users[userID]
 
@@ -251,6 +788,17 @@ const (
 	MtvRoomTracksListScoreUpdate                  brainy.EventType = "TRACKS_LIST_SCORE_UPDATE"
 	MtvRoomUpdateDelegationOwner                  brainy.EventType = "UPDATE_DELEGATION_OWNER"
 	MtvRoomControlAndDelegationPermission         brainy.EventType = "UPDATE_CONTROL_AND_DELEGATION_PERMISSION"
+	MtvRoomVoteToSkipCurrentTrack                 brainy.EventType = "VOTE_TO_SKIP_CURRENT_TRACK"
+	MtvRoomDownvoteTrackEvent                     brainy.EventType = "DOWNVOTE_TRACK"
+	MtvRoomUnvoteForTrackEvent                    brainy.EventType = "UNVOTE_FOR_TRACK"
+	MtvRoomAutofillTrackFetched                   brainy.EventType = "AUTOFILL_TRACK_FETCHED"
+	MtvRoomUpdateQueueMode                        brainy.EventType = "UPDATE_QUEUE_MODE"
+	MtvRoomSeek                                   brainy.EventType = "SEEK"
+	MtvRoomUpdateSettings                         brainy.EventType = "UPDATE_SETTINGS"
+	MtvRoomTransferOwnership                      brainy.EventType = "TRANSFER_OWNERSHIP"
+	MtvRoomKickUser                               brainy.EventType = "KICK_USER"
+	MtvRoomInviteUser                             brainy.EventType = "INVITE_USER"
+	MtvRoomRevokeInvitation                       brainy.EventType = "REVOKE_INVITATION"
 )
 
 func getNowFromSideEffect(ctx workflow.Context) time.Time {
@@ -338,20 +886,46 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 				isCreator := internalState.initialParams.RoomCreatorUserID == user.UserID
 				isDelegationOwner := internalState.DelegationOwnerUserID != nil && *internalState.DelegationOwnerUserID == user.UserID
+				isOwner := internalState.OwnerUserID == user.UserID
+
+				formatedUserListElement := shared_mtv.ExposedInternalStateUserListElement{
+					UserID:                            user.UserID,
+					HasControlAndDelegationPermission: user.HasControlAndDelegationPermission,
+					IsCreator:                         isCreator,
+					IsDelegationOwner:                 isDelegationOwner,
+					IsOwner:                           isOwner,
+				}
+				usersList = append(usersList, formatedUserListElement)
+			}
+
+			return usersList, nil
+		},
+	); err != nil {
+		logger.Info("SetQueryHandler for getUsersList failed.", "Error", err)
+		return err
+	}
+
+	if err := workflow.SetQueryHandler(
+		ctx,
+		shared_mtv.MtvGetHistoryQuery,
+		func(page int, limit int) (shared_mtv.MtvRoomExposedHistory, error) {
+
+			return internalState.ExportHistory(page, limit), nil
+		},
+	); err != nil {
+		logger.Info("SetQueryHandler for getHistory failed.", "Error", err)
+		return err
+	}
 
-				formatedUserListElement := shared_mtv.ExposedInternalStateUserListElement{
-					UserID:                            user.UserID,
-					HasControlAndDelegationPermission: user.HasControlAndDelegationPermission,
-					IsCreator:                         isCreator,
-					IsDelegationOwner:                 isDelegationOwner,
-				}
-				usersList = append(usersList, formatedUserListElement)
-			}
+	if err := workflow.SetQueryHandler(
+		ctx,
+		shared_mtv.MtvGetPendingInvitationsQuery,
+		func() ([]shared_mtv.MtvRoomInvitation, error) {
 
-			return usersList, nil
+			return internalState.PendingInvitations(), nil
 		},
 	); err != nil {
-		logger.Info("SetQueryHandler for getUsersList failed.", "Error", err)
+		logger.Info("SetQueryHandler for getPendingInvitations failed.", "Error", err)
 		return err
 	}
 
@@ -362,13 +936,89 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 		workflowFatalError                       error
 		timerExpirationFuture                    workflow.Future
 		fetchedInitialTracksFuture               workflow.Future
+		fetchedAutofillTrackFuture               workflow.Future
+		fetchedAutofillTrackID                   string
 		fetchedSuggestedTracksInformationFutures []workflow.Future
-		voteIntervalTimerFuture                  workflow.Future
+		//Outcome of the suggestions that does not depend on the fetching,
+		//reported along with the fetched tracks
+		pendingSuggestionsByFuture = make(map[workflow.Future]pendingSuggestion)
+		voteIntervalTimerFuture    workflow.Future
 
 		timeConstraintStartsAtTimer workflow.Future
 		timeConstraintEndsAtTimer   workflow.Future
+		cancelTimeConstraintTimers  workflow.CancelFunc
 	)
 
+	notifySuggestOrVoteUpdateIfNeeded := func(c brainy.Context, e brainy.Event) error {
+		tracksListsAreEqual := internalState.Tracks.DeepEqual(internalState.TracksCheckForVoteUpdateLastSave)
+		currentTrackAreEqual := internalState.CurrentTrack.DeepEqual(internalState.CurrentTrackCheckForVoteUpdateLastSave)
+		needToNotifySuggestOrVoteUpdateActivity := !(tracksListsAreEqual && currentTrackAreEqual)
+
+		if needToNotifySuggestOrVoteUpdateActivity {
+			sendNotifySuggestOrVoteUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
+			internalState.TracksCheckForVoteUpdateLastSave = internalState.Tracks.Clone()
+			internalState.CurrentTrackCheckForVoteUpdateLastSave = internalState.CurrentTrack
+			voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+		} else {
+			voteIntervalTimerFuture = nil
+			internalState.TracksCheckForVoteUpdateLastSave = shared_mtv.TracksMetadataWithScoreSet{}
+			internalState.CurrentTrackCheckForVoteUpdateLastSave = shared_mtv.CurrentTrack{}
+		}
+
+		return nil
+	}
+
+	fetchNextAutofillTrack := func() {
+		now := getNowFromSideEffect(ctx)
+		autofillTrackID, found := internalState.PickAutofillTrackID(now)
+		if !found {
+			return
+		}
+
+		fetchedAutofillTrackID = autofillTrackID
+		fetchedAutofillTrackFuture = sendFetchTracksInformationActivity(ctx, []string{autofillTrackID})
+	}
+
+	//Time constraint timers are created at room creation and re-armed after a settings update
+	armTimeConstraintTimers := func(now time.Time) {
+		if cancelTimeConstraintTimers != nil {
+			cancelTimeConstraintTimers()
+		}
+		timeConstraintStartsAtTimer = nil
+		timeConstraintEndsAtTimer = nil
+		internalState.timeConstraintIsValid = nil
+
+		roomHasConstraint := internalState.initialParams.HasPhysicalAndTimeConstraints && internalState.initialParams.PhysicalAndTimeConstraints != nil
+		if !roomHasConstraint {
+			return
+		}
+
+		var timersCtx workflow.Context
+		timersCtx, cancelTimeConstraintTimers = workflow.WithCancel(ctx)
+
+		start := internalState.initialParams.PhysicalAndTimeConstraints.PhysicalConstraintStartsAt
+		end := internalState.initialParams.PhysicalAndTimeConstraints.PhysicalConstraintEndsAt
+
+		//If start is in the future we will need to notify users about
+		//toggle on of the time constraint status
+		//If it's not no need to send any event as the creation will manage it
+		//But we then set the timeConstaintIsValid value to true
+		startIsAfterNow := start.After(now)
+		if startIsAfterNow {
+			fmt.Println("Mtv room with constraint: start is after now creating a timer")
+			startLessNow := start.Sub(now)
+			timeConstraintStartsAtTimer = workflow.NewTimer(timersCtx, startLessNow)
+			internalState.timeConstraintIsValid = &shared_mtv.FalseValue
+		} else {
+			fmt.Println("Mtv room with constraint: start is before not creating a timer")
+			internalState.timeConstraintIsValid = &shared_mtv.TrueValue
+		}
+
+		endLessNow := end.Sub(now)
+		timeConstraintEndsAtTimer = workflow.NewTimer(timersCtx, endLessNow)
+	}
+
 	internalState.Machine, err = brainy.NewMachine(brainy.StateNode{
 		Initial: MtvRoomFetchInitialTracks,
 
@@ -379,29 +1029,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
 							//Create timers future
-
-							roomHasConstraint := internalState.initialParams.HasPhysicalAndTimeConstraints && internalState.initialParams.PhysicalAndTimeConstraints != nil
-							if roomHasConstraint {
-								start := internalState.initialParams.PhysicalAndTimeConstraints.PhysicalConstraintStartsAt
-								end := internalState.initialParams.PhysicalAndTimeConstraints.PhysicalConstraintEndsAt
-
-								//If start is in the future we will need to notify users about
-								//toggle on of the time constraint status
-								//If it's not no need to send any event as the creation will manage it
-								//But we then set the timeConstaintIsValid value to true
-								startIsAfterNow := start.After(rootNow)
-								if startIsAfterNow {
-									fmt.Println("Mtv room with constraint: start is after now creating a timer")
-									startLessNow := start.Sub(rootNow)
-									timeConstraintStartsAtTimer = workflow.NewTimer(ctx, startLessNow)
-								} else {
-									fmt.Println("Mtv room with constraint: start is before not creating a timer")
-									internalState.timeConstraintIsValid = &shared_mtv.TrueValue
-								}
-
-								endLessNow := end.Sub(rootNow)
-								timeConstraintEndsAtTimer = workflow.NewTimer(ctx, endLessNow)
-							}
+							armTimeConstraintTimers(rootNow)
 							///
 							fetchedInitialTracksFuture = sendFetchTracksInformationActivity(ctx, internalState.initialParams.InitialTracksIDsList)
 
@@ -416,7 +1044,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 						Actions: brainy.Actions{
 							brainy.ActionFn(
-								assignInitialFetchedTracks(&internalState),
+								assignInitialFetchedTracks(ctx, &internalState),
 							),
 							brainy.ActionFn(
 								func(c brainy.Context, e brainy.Event) error {
@@ -461,7 +1089,26 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 						Actions: brainy.Actions{
 							brainy.ActionFn(
-								assignNextTrack(&internalState),
+								assignNextTrack(ctx, &internalState),
+							),
+						},
+					},
+
+					MtvRoomSeek: brainy.Transition{
+						Cond: userHasPermissionToSeekCurrentTrack(&internalState),
+
+						Actions: brainy.Actions{
+							brainy.ActionFn(
+								assignSeekPosition(ctx, &internalState),
+							),
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									event := e.(MtvRoomSeekEvent)
+
+									sendAcknowledgeSeekActivity(ctx, internalState.Export(event.UserID))
+
+									return nil
+								},
 							),
 						},
 					},
@@ -498,6 +1145,10 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 									totalDuration := internalState.CurrentTrack.Duration - internalState.CurrentTrack.AlreadyElapsed
 
+									if internalState.CurrentTrackStartedAt.IsZero() {
+										internalState.CurrentTrackStartedAt = createdOn
+									}
+
 									internalState.Timer = shared_mtv.MtvRoomTimer{
 										Cancel:    cancelTimerHandler,
 										CreatedOn: createdOn,
@@ -543,7 +1194,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									Cond: func(c brainy.Context, e brainy.Event) bool {
 										timerExpirationEvent := e.(MtvRoomTimerExpirationEvent)
 										currentTrackEnded := timerExpirationEvent.Reason == shared_mtv.MtvRoomTimerExpiredReasonFinished
-										nextTrackIsReadyToBePlayed := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+										nextTrackIsReadyToBePlayed := internalState.HasNextTrackToPlay()
 										nextTrackIsNotReadyToBePlayed := !nextTrackIsReadyToBePlayed
 
 										return currentTrackEnded && nextTrackIsNotReadyToBePlayed
@@ -559,6 +1210,19 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 												internalState.CurrentTrack.AlreadyElapsed += event.Timer.Duration
 
+												return nil
+											},
+										),
+										brainy.ActionFn(
+											func(c brainy.Context, e brainy.Event) error {
+												autofillIsDisabled := internalState.initialParams.Autofill == nil
+												if autofillIsDisabled || fetchedAutofillTrackFuture != nil {
+													return nil
+												}
+
+												internalState.ResetAutofillFailedTracks()
+												fetchNextAutofillTrack()
+
 												return nil
 											},
 										),
@@ -569,7 +1233,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									Cond: func(c brainy.Context, e brainy.Event) bool {
 										timerExpirationEvent := e.(MtvRoomTimerExpirationEvent)
 										currentTrackEnded := timerExpirationEvent.Reason == shared_mtv.MtvRoomTimerExpiredReasonFinished
-										nextTrackIsReadyToBePlayed := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+										nextTrackIsReadyToBePlayed := internalState.HasNextTrackToPlay()
 
 										if currentTrackEnded {
 											fmt.Println("__TRACK IS FINISHED GOING TO THE NEXT ONE__")
@@ -582,7 +1246,16 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 									Actions: brainy.Actions{
 										brainy.ActionFn(
-											assignNextTrack(&internalState),
+											func(c brainy.Context, e brainy.Event) error {
+												event := e.(MtvRoomTimerExpirationEvent)
+
+												internalState.CurrentTrack.AlreadyElapsed += event.Timer.Duration
+
+												return nil
+											},
+										),
+										brainy.ActionFn(
+											assignNextTrack(ctx, &internalState),
 										),
 									},
 								},
@@ -623,6 +1296,33 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									),
 								},
 							},
+
+							//The running timer is replaced by a new one launched for the remaining duration
+							MtvRoomSeek: brainy.Transition{
+								Target: MtvRoomPlayingLauchingTimerState,
+
+								Cond: userHasPermissionToSeekCurrentTrack(&internalState),
+
+								//The play activity sent when launching the timer
+								//acknowledges the seek
+								Actions: brainy.Actions{
+									//The position is read from the running timer
+									brainy.ActionFn(
+										assignSeekPosition(ctx, &internalState),
+									),
+									brainy.ActionFn(
+										func(c brainy.Context, e brainy.Event) error {
+											//Canceled timer future is dropped when the new timer is launched
+											if cancel := internalState.Timer.Cancel; cancel != nil {
+												cancel()
+											}
+											internalState.Timer = shared_mtv.MtvRoomTimer{}
+
+											return nil
+										},
+									),
+								},
+							},
 						},
 					},
 
@@ -661,23 +1361,137 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 			// Isn't risky to listen those events while we're in the state `MtvRoomFetchInitialTracks` ?
 			// Shall we create a intermediate state between ? something like `workflowIsReady` ?
-			MtvRoomAddUserEvent: brainy.Transition{
+			MtvRoomAddUserEvent: brainy.Transitions{
+				{
+					Cond: userIsNotAllowedToJoinRoom(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomUserJoiningRoomEvent)
+
+								sendRejectJoinActivity(ctx, activities_mtv.RejectJoinArgs{
+									RoomID:   internalState.initialParams.RoomID,
+									UserID:   event.User.UserID,
+									DeviceID: event.User.DeviceID,
+								})
+
+								return nil
+							},
+						),
+					},
+				},
+
+				{
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomUserJoiningRoomEvent)
+
+								internalState.AddUser(event.User)
+
+								joinActivityArgs := activities_mtv.MtvJoinCallbackRequestBody{
+									State:         internalState.Export(event.User.UserID),
+									JoiningUserID: event.User.UserID,
+								}
+								sendJoinActivity(ctx, joinActivityArgs)
+								sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+								return nil
+							},
+						),
+					},
+				},
+			},
+
+			MtvRoomInviteUser: brainy.Transition{
+				Cond: userCanInviteUser(&internalState),
+
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomInviteUserEvent)
+
+							internalState.InviteUser(event.UserID, event.InvitedUserID)
+
+							sendAcknowledgeInviteUserActivity(ctx, activities_mtv.AcknowledgeInviteUserArgs{
+								State:          internalState.Export(shared_mtv.NoRelatedUserID),
+								InvitingUserID: event.UserID,
+								InvitedUserID:  event.InvitedUserID,
+							})
+
+							return nil
+						},
+					),
+				},
+			},
+
+			MtvRoomRevokeInvitation: brainy.Transition{
+				Cond: userCanRevokeInvitation(&internalState),
+
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomRevokeInvitationEvent)
+
+							internalState.RevokeInvitation(event.InvitedUserID)
+
+							sendAcknowledgeRevokeInvitationActivity(ctx, activities_mtv.AcknowledgeRevokeInvitationArgs{
+								State:         internalState.Export(shared_mtv.NoRelatedUserID),
+								InvitedUserID: event.InvitedUserID,
+							})
+
+							return nil
+						},
+					),
+				},
+			},
+
+			MtvRoomKickUser: brainy.Transition{
+				Cond: userCanKickUser(&internalState),
+
 				Actions: brainy.Actions{
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
-							event := e.(MtvRoomUserJoiningRoomEvent)
+							event := e.(MtvRoomKickUserEvent)
+
+							if event.Ban {
+								internalState.BanUser(event.KickedUserID)
+								internalState.RevokeInvitation(event.KickedUserID)
+							}
+
+							//A banned user who is not in the room has no device to disconnect
+							kickedUserDeviceID := ""
+							kickedUserIsInRoom := internalState.HasUser(event.KickedUserID)
+							if kickedUserIsInRoom {
+								kickedUser := internalState.GetUserRelatedInformation(event.KickedUserID)
+								kickedUserDeviceID = kickedUser.DeviceID
+
+								internalState.PurgeUserVotes(event.KickedUserID)
+								internalState.RemoveUserAndReassignRoles(event.KickedUserID)
 
-							internalState.AddUser(event.User)
+								if voteIntervalTimerFuture == nil {
+									voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+								}
+							}
 
-							joinActivityArgs := activities_mtv.MtvJoinCallbackRequestBody{
-								State:         internalState.Export(event.User.UserID),
-								JoiningUserID: event.User.UserID,
+							sendAcknowledgeKickUserActivity(ctx, activities_mtv.AcknowledgeKickUserArgs{
+								State:          internalState.Export(shared_mtv.NoRelatedUserID),
+								KickedUserID:   event.KickedUserID,
+								KickedDeviceID: kickedUserDeviceID,
+								Banned:         event.Ban,
+							})
+
+							if kickedUserIsInRoom {
+								sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
 							}
-							sendJoinActivity(ctx, joinActivityArgs)
-							sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
 							return nil
 						},
 					),
+					//Purged downvotes can make the first queued track playable
+					brainy.Send(
+						MtvRoomTracksListScoreUpdate,
+					),
 				},
 			},
 
@@ -706,29 +1520,97 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				},
 			},
 
-			MtvCheckForScoreUpdateIntervalExpirationEvent: brainy.Transition{
+			MtvRoomUnvoteForTrackEvent: brainy.Transition{
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomUserUnvoteForTrackEvent)
+
+							success := internalState.UserUnvoteForTrack(event.UserID, event.TrackID)
+							if success {
+
+								if voteIntervalTimerFuture == nil {
+									voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+								}
+
+								trackHasBeenEvicted := internalState.EvictTrackIfScoreIsBelowFloor(event.TrackID)
+
+								sendUserUnvoteForTrackAcknowledgementActivity(ctx, internalState.Export(event.UserID))
+
+								if trackHasBeenEvicted {
+									sendNotifyTrackEvictedActivity(ctx, activities_mtv.NotifyTrackEvictedArgs{
+										State:          internalState.Export(shared_mtv.NoRelatedUserID),
+										EvictedTrackID: event.TrackID,
+									})
+								}
+							}
+
+							return nil
+						},
+					),
+					brainy.Send(
+						MtvRoomTracksListScoreUpdate,
+					),
+				},
+			},
+
+			MtvRoomDownvoteTrackEvent: brainy.Transition{
 				Actions: brainy.Actions{
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
-							tracksListsAreEqual := internalState.Tracks.DeepEqual(internalState.TracksCheckForVoteUpdateLastSave)
-							currentTrackAreEqual := internalState.CurrentTrack.DeepEqual(internalState.CurrentTrackCheckForVoteUpdateLastSave)
-							needToNotifySuggestOrVoteUpdateActivity := !(tracksListsAreEqual && currentTrackAreEqual)
-
-							if needToNotifySuggestOrVoteUpdateActivity {
-								sendNotifySuggestOrVoteUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
-
-								internalState.TracksCheckForVoteUpdateLastSave = internalState.Tracks.Clone()
-								internalState.CurrentTrackCheckForVoteUpdateLastSave = internalState.CurrentTrack
-								voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
-							} else {
-								voteIntervalTimerFuture = nil
-								internalState.TracksCheckForVoteUpdateLastSave = shared_mtv.TracksMetadataWithScoreSet{}
-								internalState.CurrentTrackCheckForVoteUpdateLastSave = shared_mtv.CurrentTrack{}
+							event := e.(MtvRoomUserDownvoteTrackEvent)
+
+							success := internalState.UserDownvoteTrack(event.UserID, event.TrackID)
+							if success {
+
+								if voteIntervalTimerFuture == nil {
+									voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+								}
+
+								trackHasBeenEvicted := internalState.EvictTrackIfScoreIsBelowFloor(event.TrackID)
+
+								sendUserDownvoteTrackAcknowledgementActivity(ctx, internalState.Export(event.UserID))
+
+								if trackHasBeenEvicted {
+									sendNotifyTrackEvictedActivity(ctx, activities_mtv.NotifyTrackEvictedArgs{
+										State:          internalState.Export(shared_mtv.NoRelatedUserID),
+										EvictedTrackID: event.TrackID,
+									})
+								}
 							}
 
 							return nil
 						},
 					),
+					brainy.Send(
+						MtvRoomTracksListScoreUpdate,
+					),
+				},
+			},
+
+			MtvCheckForScoreUpdateIntervalExpirationEvent: brainy.Transitions{
+				//A skip vote that reached the threshold while no next track was ready
+				{
+					Target: MtvRoomPlayingState,
+
+					Cond: skipVotesReachThresholdAndHasNextTrackToPlay(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							assignNextTrack(ctx, &internalState),
+						),
+						brainy.ActionFn(
+							notifySuggestOrVoteUpdateIfNeeded,
+						),
+					},
+				},
+
+				{
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							notifySuggestOrVoteUpdateIfNeeded,
+						),
+					},
 				},
 			},
 
@@ -738,44 +1620,106 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 						func(c brainy.Context, e brainy.Event) error {
 							event := e.(MtvRoomTimeConstraintTimerExpirationEvent)
 
-							internalState.timeConstraintIsValid = &event.TimeConstraintValue
-							sendAcknowledgeUpdateTimeConstraintActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+							internalState.timeConstraintIsValid = &event.TimeConstraintValue
+							sendAcknowledgeUpdateTimeConstraintActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+							return nil
+						},
+					),
+				},
+			},
+
+			MtvRoomUpdateUserFitsPositionConstraint: brainy.Transition{
+				Cond: roomHasPositionAndTimeConstraint(&internalState),
+
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomUpdateUserFitsPositionConstraintEvent)
+
+							success := internalState.UpdateUserFitsPositionConstraint(event.UserID, event.UserFitsPositionConstraint)
+
+							if success {
+								sendAcknowledgeUpdateUserFitsPositionConstraintActivity(ctx, internalState.Export(event.UserID))
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+
+			MtvRoomUpdateDelegationOwner: brainy.Transition{
+				Cond: roomPlayingModeIsDirectAndUserExistsAndEmitterHasPermissions(&internalState),
+
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomUpdateDelegationOwnerEvent)
+
+							internalState.DelegationOwnerUserID = &event.NewDelegationOwnerUserID
+							sendAcknowledgeUpdateDelegationOwnerActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
+							return nil
+						},
+					),
+				},
+			},
+
+			MtvRoomControlAndDelegationPermission: brainy.Transition{
+				Cond: userToUpdateExists(&internalState),
+
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomUpdateControlAndDelegationPermissionEvent)
+
+							userToUpdate := internalState.GetUserRelatedInformation(event.ToUpdateUserID)
+
+							userToUpdate.HasControlAndDelegationPermission = event.HasControlAndDelegationPermission
+
+							sendAcknowledgeUpdateControlAndDelegationPermissionActivity(
+								ctx,
+								internalState.Export(event.ToUpdateUserID),
+							)
+
 							return nil
 						},
 					),
 				},
 			},
 
-			MtvRoomUpdateUserFitsPositionConstraint: brainy.Transition{
-				Cond: roomHasPositionAndTimeConstraint(&internalState),
+			MtvRoomUpdateSettings: brainy.Transition{
+				Cond: userIsRoomOwnerAndSettingsAreValid(&internalState),
 
 				Actions: brainy.Actions{
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
-							event := e.(MtvRoomUpdateUserFitsPositionConstraintEvent)
+							event := e.(MtvRoomUpdateSettingsEvent)
 
-							success := internalState.UpdateUserFitsPositionConstraint(event.UserID, event.UserFitsPositionConstraint)
+							internalState.UpdateSettings(event.Settings)
+							armTimeConstraintTimers(event.Now)
 
-							if success {
-								sendAcknowledgeUpdateUserFitsPositionConstraintActivity(ctx, internalState.Export(event.UserID))
-							}
+							sendAcknowledgeUpdateSettingsActivity(ctx, internalState.Export(event.UserID))
 
 							return nil
 						},
 					),
+					//The minimum score to be played might have changed
+					brainy.Send(MtvRoomTracksListScoreUpdate),
 				},
 			},
 
-			MtvRoomUpdateDelegationOwner: brainy.Transition{
-				Cond: roomPlayingModeIsDirectAndUserExistsAndEmitterHasPermissions(&internalState),
+			MtvRoomTransferOwnership: brainy.Transition{
+				Cond: userIsRoomOwnerAndNewOwnerIsInRoom(&internalState),
 
 				Actions: brainy.Actions{
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
-							event := e.(MtvRoomUpdateDelegationOwnerEvent)
+							event := e.(MtvRoomTransferOwnershipEvent)
 
-							internalState.DelegationOwnerUserID = &event.NewDelegationOwnerUserID
-							sendAcknowledgeUpdateDelegationOwnerActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+							internalState.TransferOwnership(event.NewOwnerUserID)
+
+							sendAcknowledgeTransferOwnershipActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
 
 							return nil
 						},
@@ -783,26 +1727,25 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				},
 			},
 
-			MtvRoomControlAndDelegationPermission: brainy.Transition{
-				Cond: userToUpdateExists(&internalState),
+			MtvRoomUpdateQueueMode: brainy.Transition{
+				Cond: userHasPermissionToUpdateQueueMode(&internalState),
 
 				Actions: brainy.Actions{
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
-							event := e.(MtvRoomUpdateControlAndDelegationPermissionEvent)
-
-							userToUpdate := internalState.GetUserRelatedInformation(event.ToUpdateUserID)
+							event := e.(MtvRoomUpdateQueueModeEvent)
 
-							userToUpdate.HasControlAndDelegationPermission = event.HasControlAndDelegationPermission
+							internalState.QueueMode = event.QueueMode
 
-							sendAcknowledgeUpdateControlAndDelegationPermissionActivity(
-								ctx,
-								internalState.Export(event.ToUpdateUserID),
-							)
+							sendAcknowledgeUpdateQueueModeActivity(ctx, internalState.Export(event.UserID))
 
 							return nil
 						},
 					),
+					//Switching to LOOP mode can make the current track playable again
+					brainy.Send(
+						MtvRoomTracksListScoreUpdate,
+					),
 				},
 			},
 
@@ -812,21 +1755,20 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 						func(c brainy.Context, e brainy.Event) error {
 							event := e.(MtvRoomUserLeavingRoomEvent)
 
-							success := internalState.RemoveUser(event.UserID)
+							success := internalState.RemoveUserAndReassignRoles(event.UserID)
 
 							if success {
-								roomIsInDirectMode := internalState.initialParams.PlayingMode == shared_mtv.MtvPlayingModeDirect
-								delegationOwnerIsLeavingRoom := internalState.DelegationOwnerUserID != nil && *internalState.DelegationOwnerUserID == event.UserID
-								if delegationOwnerIsLeavingRoom && roomIsInDirectMode {
-									internalState.DelegationOwnerUserID = &(internalState.initialParams.RoomCreatorUserID)
-								}
-
 								joinActivityArgs := activities_mtv.AcknowledgeLeaveRoomRequestBody{
 									LeavingUserID: event.UserID,
 									State:         internalState.Export(shared_mtv.NoRelatedUserID),
 								}
 								sendLeaveActivity(ctx, joinActivityArgs)
 								sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
+								//Less votes might now be required to skip the current track
+								if internalState.SkipVotesReachThreshold() && voteIntervalTimerFuture == nil {
+									voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+								}
 							}
 
 							return nil
@@ -842,11 +1784,42 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 				Actions: brainy.Actions{
 					brainy.ActionFn(
-						assignNextTrack(&internalState),
+						assignNextTrack(ctx, &internalState),
 					),
 				},
 			},
 
+			MtvRoomVoteToSkipCurrentTrack: brainy.Transitions{
+				{
+					Target: MtvRoomPlayingState,
+
+					Cond: userCanVoteToSkipAndVoteReachesThresholdAndHasNextTrackToPlay(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							assignNextTrack(ctx, &internalState),
+						),
+					},
+				},
+
+				{
+					Cond: userCanVoteToSkipCurrentTrack(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomVoteToSkipCurrentTrackEvent)
+
+								internalState.UserVoteToSkipCurrentTrack(event.UserID)
+								sendAcknowledgeVoteToSkipCurrentTrackActivity(ctx, internalState.Export(event.UserID))
+
+								return nil
+							},
+						),
+					},
+				},
+			},
+
 			MtvRoomSuggestTracks: brainy.Transition{
 				Actions: brainy.Actions{
 					brainy.ActionFn(
@@ -854,7 +1827,16 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 							event := e.(MtvRoomSuggestTracksEvent)
 
 							acceptedSuggestedTracksIDs := make([]string, 0, len(event.TracksToSuggest))
+							isAcceptedSuggestedTrack := make(map[string]bool, len(event.TracksToSuggest))
 							succesfullSuggestIntoVoteTracksIDs := make([]string, 0, len(event.TracksToSuggest))
+							rejectedTracks := make([]shared_mtv.RejectedTrack, 0)
+
+							suggestionCooldown := internalState.initialParams.SuggestionCooldown
+							var now time.Time
+							if suggestionCooldown != nil && suggestionCooldown.Kind == shared_mtv.MtvSuggestionCooldownKindDuration {
+								now = getNowFromSideEffect(ctx)
+							}
+
 							for _, suggestedTrackID := range event.TracksToSuggest {
 
 								//Checking if the suggested track is in the player
@@ -863,6 +1845,16 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									continue
 								}
 
+								//Checking if the suggested track has been played too recently
+								isCoolingDown := suggestionCooldown != nil && suggestionCooldown.IsCoolingDown(&internalState.PlayedTracks, suggestedTrackID, now)
+								if isCoolingDown {
+									rejectedTracks = append(rejectedTracks, shared_mtv.RejectedTrack{
+										TrackID: suggestedTrackID,
+										Reason:  shared_mtv.TrackRejectionReasonRecentlyPlayed,
+									})
+									continue
+								}
+
 								//Checking if the suggested track is in the queue
 								isDuplicate := internalState.Tracks.Has(suggestedTrackID)
 								if isDuplicate {
@@ -878,6 +1870,12 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									continue
 								}
 
+								//Tracks ids are canonical, the same track can not be suggested twice
+								if isAcceptedSuggestedTrack[suggestedTrackID] {
+									continue
+								}
+								isAcceptedSuggestedTrack[suggestedTrackID] = true
+
 								acceptedSuggestedTracksIDs = append(acceptedSuggestedTracksIDs, suggestedTrackID)
 							}
 
@@ -887,13 +1885,15 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 							if hasNoTracksToFetch {
 								if hasNoSuccessfullVoteForDuplicate {
 									sendAcknowledgeTracksSuggestionFailActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionFailArgs{
-										DeviceID: event.DeviceID,
+										DeviceID:       event.DeviceID,
+										RejectedTracks: rejectedTracks,
 									})
 
 								} else {
 									sendAcknowledgeTracksSuggestionActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionArgs{
-										DeviceID: event.DeviceID,
-										State:    internalState.Export(event.UserID),
+										DeviceID:       event.DeviceID,
+										State:          internalState.Export(event.UserID),
+										RejectedTracks: rejectedTracks,
 									})
 								}
 								return nil
@@ -902,6 +1902,29 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 							fetchingFuture := sendFetchTracksInformationActivityAndForwardInitiator(ctx, acceptedSuggestedTracksIDs, event.UserID, event.DeviceID)
 
 							fetchedSuggestedTracksInformationFutures = append(fetchedSuggestedTracksInformationFutures, fetchingFuture)
+							pendingSuggestionsByFuture[fetchingFuture] = pendingSuggestion{
+								RejectedTracks:          rejectedTracks,
+								HasVotedForQueuedTracks: !hasNoSuccessfullVoteForDuplicate,
+							}
+
+							return nil
+						},
+					),
+					brainy.Send(
+						MtvRoomTracksListScoreUpdate,
+					),
+				},
+			},
+
+			MtvRoomAutofillTrackFetched: brainy.Transition{
+				Cond: currentTrackEndedAndNextTrackIsNotReadyToBePlayed(&internalState),
+
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomAutofillTrackFetchedEvent)
+
+							internalState.AddAutofillTrack(event.Track, getNowFromSideEffect(ctx))
 
 							return nil
 						},
@@ -917,12 +1940,15 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
 							event := e.(MtvRoomSuggestedTracksFetchedEvent)
+							now := getNowFromSideEffect(ctx)
 
 							for _, trackInformation := range event.SuggestedTracksInformation {
 								suggestedTrackInformation := shared_mtv.TrackMetadataWithScore{
 									TrackMetadata: trackInformation,
 
-									Score: 0,
+									Score:         0,
+									AddedByUserID: event.UserID,
+									AddedAt:       now,
 								}
 
 								internalState.Tracks.Add(suggestedTrackInformation)
@@ -936,9 +1962,21 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 								}
 							}
 
+							//A single acknowledgement is sent per suggestion
+							hasAcceptedTracks := len(event.SuggestedTracksInformation) > 0 || event.HasVotedForQueuedTracks
+							if !hasAcceptedTracks {
+								sendAcknowledgeTracksSuggestionFailActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+									DeviceID:       event.DeviceID,
+									RejectedTracks: event.RejectedTracks,
+								})
+
+								return nil
+							}
+
 							sendAcknowledgeTracksSuggestionActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionArgs{
-								DeviceID: event.DeviceID,
-								State:    internalState.Export(event.UserID),
+								DeviceID:       event.DeviceID,
+								State:          internalState.Export(event.UserID),
+								RejectedTracks: event.RejectedTracks,
 							})
 
 							return nil
@@ -1081,7 +2119,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 				internalState.Machine.Send(
 					NewMtvRoomSuggestTracksEvent(NewMtvRoomSuggestTracksEventArgs{
-						TracksToSuggest: message.TracksToSuggest,
+						TracksToSuggest: providers.CanonicalTracksIDs(message.TracksToSuggest),
 						UserID:          message.UserID,
 						DeviceID:        message.DeviceID,
 					}),
@@ -1116,7 +2154,39 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				}
 
 				internalState.Machine.Send(
-					NewMtvRoomUserVoteForTrackEvent(message.UserID, message.TrackID),
+					NewMtvRoomUserVoteForTrackEvent(message.UserID, providers.CanonicalTrackID(message.TrackID)),
+				)
+
+			case shared_mtv.SignalRouteUnvoteForTrack:
+				var message shared_mtv.UnvoteForTrackSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUserUnvoteForTrackEvent(message.UserID, providers.CanonicalTrackID(message.TrackID)),
+				)
+
+			case shared_mtv.SignalRouteDownvoteTrack:
+				var message shared_mtv.DownvoteTrackSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUserDownvoteTrackEvent(message.UserID, providers.CanonicalTrackID(message.TrackID)),
 				)
 
 			case shared_mtv.SignalUpdateUserFitsPositionConstraint:
@@ -1170,6 +2240,179 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					}),
 				)
 
+			case shared_mtv.SignalRouteVoteToSkipCurrentTrack:
+				var message shared_mtv.VoteToSkipCurrentTrackSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomVoteToSkipCurrentTrackEvent(NewMtvRoomVoteToSkipCurrentTrackEventArgs{
+						UserID: message.UserID,
+					}),
+				)
+
+			case shared_mtv.SignalRouteUpdateQueueMode:
+				var message shared_mtv.UpdateQueueModeSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUpdateQueueModeEvent(NewMtvRoomUpdateQueueModeEventArgs{
+						UserID:    message.UserID,
+						QueueMode: message.QueueMode,
+					}),
+				)
+
+			case shared_mtv.SignalRouteSeek:
+				var message shared_mtv.SeekSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomSeekEvent(NewMtvRoomSeekEventArgs{
+						UserID:   message.UserID,
+						Position: time.Duration(message.Position) * time.Millisecond,
+					}),
+				)
+
+			case shared_mtv.SignalRouteUpdateSettings:
+				var message shared_mtv.UpdateSettingsSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUpdateSettingsEvent(NewMtvRoomUpdateSettingsEventArgs{
+						UserID:   message.UserID,
+						Settings: message.Settings,
+						Now:      getNowFromSideEffect(ctx),
+					}),
+				)
+
+			case shared_mtv.SignalRouteTransferOwnership:
+				var message shared_mtv.TransferOwnershipSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomTransferOwnershipEvent(NewMtvRoomTransferOwnershipEventArgs{
+						UserID:         message.UserID,
+						NewOwnerUserID: message.NewOwnerUserID,
+					}),
+				)
+
+			case shared_mtv.SignalRouteKickUser:
+				var message shared_mtv.KickUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomKickUserEvent(NewMtvRoomKickUserEventArgs{
+						UserID:       message.UserID,
+						KickedUserID: message.KickedUserID,
+						Ban:          false,
+					}),
+				)
+
+			case shared_mtv.SignalRouteBanUser:
+				var message shared_mtv.BanUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomKickUserEvent(NewMtvRoomKickUserEventArgs{
+						UserID:       message.UserID,
+						KickedUserID: message.BannedUserID,
+						Ban:          true,
+					}),
+				)
+
+			case shared_mtv.SignalRouteInviteUser:
+				var message shared_mtv.InviteUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomInviteUserEvent(NewMtvRoomInviteUserEventArgs{
+						UserID:        message.UserID,
+						InvitedUserID: message.InvitedUserID,
+					}),
+				)
+
+			case shared_mtv.SignalRouteRevokeInvitation:
+				var message shared_mtv.RevokeInvitationSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomRevokeInvitationEvent(NewMtvRoomRevokeInvitationEventArgs{
+						UserID:        message.UserID,
+						InvitedUserID: message.InvitedUserID,
+					}),
+				)
+
 			case shared_mtv.SignalRouteTerminate:
 				terminated = true
 			default:
@@ -1215,11 +2458,46 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 		}
 
 		// Room Is Ready callback
+		if fetchedAutofillTrackFuture != nil {
+			selector.AddFuture(fetchedAutofillTrackFuture, func(f workflow.Future) {
+				fetchedAutofillTrackFuture = nil
+
+				var autofillTrackActivityResult activities.FetchedTracksInformation
+
+				//The next candidate is tried when the track could not be fetched
+				if err := f.Get(ctx, &autofillTrackActivityResult); err != nil {
+					logger.Error("error occured autofillTrackActivityResult", err)
+
+					internalState.MarkAutofillTrackAsFailed(fetchedAutofillTrackID)
+					fetchNextAutofillTrack()
+
+					return
+				}
+
+				if len(autofillTrackActivityResult.Metadata) == 0 {
+					logger.Info(
+						"autofill track could not be fetched",
+						"trackID", fetchedAutofillTrackID,
+						"statuses", autofillTrackActivityResult.Statuses,
+					)
+
+					internalState.MarkAutofillTrackAsFailed(fetchedAutofillTrackID)
+					fetchNextAutofillTrack()
+
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomAutofillTrackFetchedEvent(autofillTrackActivityResult.Metadata[0]),
+				)
+			})
+		}
+
 		if fetchedInitialTracksFuture != nil {
 			selector.AddFuture(fetchedInitialTracksFuture, func(f workflow.Future) {
 				fetchedInitialTracksFuture = nil
 
-				var initialTracksActivityResult []shared.TrackMetadata
+				var initialTracksActivityResult activities.FetchedTracksInformation
 
 				if err := f.Get(ctx, &initialTracksActivityResult); err != nil {
 					logger.Error("error occured initialTracksActivityResult", err)
@@ -1227,8 +2505,12 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					return
 				}
 
+				if rejectedTracks := shared_mtv.RejectedTracksFromFetchResults(initialTracksActivityResult.Statuses); len(rejectedTracks) > 0 {
+					logger.Info("some initial tracks could not be fetched", "rejectedTracks", rejectedTracks)
+				}
+
 				internalState.Machine.Send(
-					NewMtvRoomInitialTracksFetchedEvent(initialTracksActivityResult),
+					NewMtvRoomInitialTracksFetchedEvent(initialTracksActivityResult.Metadata),
 				)
 			})
 		}
@@ -1266,6 +2548,8 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 		for index, fetchedSuggestedTracksInformationFuture := range fetchedSuggestedTracksInformationFutures {
 			selector.AddFuture(fetchedSuggestedTracksInformationFuture, func(f workflow.Future) {
 				fetchedSuggestedTracksInformationFutures = removeFutureFromSlice(fetchedSuggestedTracksInformationFutures, index)
+				suggestion := pendingSuggestionsByFuture[f]
+				delete(pendingSuggestionsByFuture, f)
 
 				var suggestedTracksInformationActivityResult activities.FetchedTracksInformationWithInitiator
 
@@ -1275,9 +2559,16 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					return
 				}
 
+				rejectedTracks := append(
+					suggestion.RejectedTracks,
+					shared_mtv.RejectedTracksFromFetchResults(suggestedTracksInformationActivityResult.Statuses)...,
+				)
+
 				internalState.Machine.Send(
 					NewMtvRoomSuggestedTracksFetchedEvent(NewMtvRoomSuggestedTracksFetchedEventArgs{
 						SuggestedTracksInformation: suggestedTracksInformationActivityResult.Metadata,
+						RejectedTracks:             rejectedTracks,
+						HasVotedForQueuedTracks:    suggestion.HasVotedForQueuedTracks,
 						UserID:                     suggestedTracksInformationActivityResult.UserID,
 						DeviceID:                   suggestedTracksInformationActivityResult.DeviceID,
 					}),
@@ -1295,6 +2586,11 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 	return workflowFatalError
 }
 
+type pendingSuggestion struct {
+	RejectedTracks          []shared_mtv.RejectedTrack
+	HasVotedForQueuedTracks bool
+}
+
 func removeFutureFromSlice(slice []workflow.Future, index int) []workflow.Future {
 	slice[index] = slice[len(slice)-1]
 	return slice[:len(slice)-1]
@@ -1303,3 +2599,7 @@ func removeFutureFromSlice(slice []workflow.Future, index int) []workflow.Future
 type TimeWrapperType func() time.Time
 
 var TimeWrapper TimeWrapperType = time.Now
+
+type RandomIntnWrapperType func(n int) int
+
+var RandomIntnWrapper RandomIntnWrapperType = rand.Intn
@@ -5,18 +5,23 @@ import (
 
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	"github.com/Devessier/brainy"
+	"go.temporal.io/sdk/workflow"
 )
 
-func assignInitialFetchedTracks(internalState *MtvRoomInternalState) brainy.Action {
+func assignInitialFetchedTracks(ctx workflow.Context, internalState *MtvRoomInternalState) brainy.Action {
 	return func(c brainy.Context, e brainy.Event) error {
 		event := e.(MtvRoomInitialTracksFetchedEvent)
 
+		now := getNowFromSideEffect(ctx)
+
 		internalState.Tracks.Clear()
 		for _, fetchedTrack := range event.Tracks {
 			trackWithScore := shared_mtv.TrackMetadataWithScore{
 				TrackMetadata: fetchedTrack,
 
-				Score: 0,
+				Score:         0,
+				AddedByUserID: internalState.initialParams.RoomCreatorUserID,
+				AddedAt:       now,
 			}
 
 			internalState.Tracks.Add(trackWithScore)
@@ -24,8 +29,8 @@ func assignInitialFetchedTracks(internalState *MtvRoomInternalState) brainy.Acti
 
 		}
 
-		if internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed) {
-			setFirstTrackAsCurrentTrack(internalState)
+		if internalState.HasNextTrackToPlay() {
+			setFirstTrackAsCurrentTrack(ctx, internalState)
 		} else {
 			internalState.Timer = shared_mtv.MtvRoomTimer{}
 		}
@@ -34,16 +39,85 @@ func assignInitialFetchedTracks(internalState *MtvRoomInternalState) brainy.Acti
 	}
 }
 
-func setFirstTrackAsCurrentTrack(internalState *MtvRoomInternalState) {
+// pickNextTrackIndex returns the index in the queue of the next track to play.
+// In SHUFFLE queue mode it is randomly picked among tracks ready to be played.
+func pickNextTrackIndex(ctx workflow.Context, internalState *MtvRoomInternalState) int {
+	queueIsShuffled := internalState.QueueMode == shared_mtv.MtvQueueModeShuffle
+	if !queueIsShuffled {
+		return 0
+	}
+
+	readyToBePlayedLen := internalState.Tracks.ReadyToBePlayedLen(internalState.initialParams.MinimumScoreToBePlayed)
+	if readyToBePlayedLen < 2 {
+		return 0
+	}
+
+	var nextTrackIndex int
+	encoded := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return RandomIntnWrapper(readyToBePlayedLen)
+	})
+	encoded.Get(&nextTrackIndex)
+
+	return nextTrackIndex
+}
+
+//Timer is still running when the position is read while playing
+func getCurrentTrackPosition(internalState *MtvRoomInternalState, now time.Time) time.Duration {
+	position := internalState.CurrentTrack.AlreadyElapsed
+	if timerIsRunning := !internalState.Timer.CreatedOn.IsZero(); timerIsRunning {
+		position += now.Sub(internalState.Timer.CreatedOn)
+	}
+
+	return position
+}
+
+// archiveCurrentTrack adds the current track to the played tracks history.
+// Tracks that have never been launched are not considered as played.
+func archiveCurrentTrack(ctx workflow.Context, internalState *MtvRoomInternalState) {
+	currentTrackHasNeverBeenPlayed := internalState.CurrentTrack.ID == "" || internalState.CurrentTrackStartedAt.IsZero()
+	if currentTrackHasNeverBeenPlayed {
+		return
+	}
+
+	now := getNowFromSideEffect(ctx)
+
+	listenedDuration := getCurrentTrackPosition(internalState, now) - internalState.currentTrackSeekOffset
+	if listenedDuration < 0 {
+		listenedDuration = 0
+	}
+	if listenedDuration > internalState.CurrentTrack.Duration {
+		listenedDuration = internalState.CurrentTrack.Duration
+	}
+
+	internalState.PlayedTracks.Add(shared_mtv.PlayedTrack{
+		TrackMetadataWithScore: internalState.CurrentTrack.TrackMetadataWithScore,
+
+		StartedAt:        internalState.CurrentTrackStartedAt,
+		EndedAt:          now,
+		ListenedDuration: listenedDuration,
+	})
+}
+
+func setFirstTrackAsCurrentTrack(ctx workflow.Context, internalState *MtvRoomInternalState) {
 	//By calling this function you assume that next track is ready to be played
 	//This should not be called outside a brainy action+cond spec
 
-	firstTrack, _ := internalState.Tracks.Shift()
+	archiveCurrentTrack(ctx, internalState)
+
+	//In LOOP queue mode the played track goes back in the queue
+	queueIsLooping := internalState.QueueMode == shared_mtv.MtvQueueModeLoop
+	if queueIsLooping && internalState.CurrentTrack.ID != "" {
+		internalState.Tracks.Requeue(internalState.CurrentTrack.TrackMetadataWithScore, internalState.initialParams.MinimumScoreToBePlayed)
+	}
+
+	firstTrack, _ := internalState.Tracks.ShiftAt(pickNextTrackIndex(ctx, internalState))
 
 	internalState.CurrentTrack = shared_mtv.CurrentTrack{
 		TrackMetadataWithScore: firstTrack,
 		AlreadyElapsed:         0,
 	}
+	internalState.CurrentTrackStartedAt = time.Time{}
+	internalState.currentTrackSeekOffset = 0
 	internalState.Timer = shared_mtv.MtvRoomTimer{
 		CreatedOn: time.Time{},
 		Duration:  internalState.CurrentTrack.Duration,
@@ -52,12 +126,28 @@ func setFirstTrackAsCurrentTrack(internalState *MtvRoomInternalState) {
 
 	//As the first track is not anymore in the tracks list, users can now suggest or vote for this song again
 	internalState.RemoveTrackFromUserTracksVotedFor(firstTrack.ID)
+	internalState.RemoveTrackFromUserTracksDownvotedFor(firstTrack.ID)
+
+	//Skip votes were targeting the previous current track
+	internalState.ResetSkipVotes()
 }
 
-func assignNextTrack(internalState *MtvRoomInternalState) brainy.Action {
+func assignNextTrack(ctx workflow.Context, internalState *MtvRoomInternalState) brainy.Action {
+
+	return func(c brainy.Context, e brainy.Event) error {
+		setFirstTrackAsCurrentTrack(ctx, internalState)
+
+		return nil
+	}
+}
 
+func assignSeekPosition(ctx workflow.Context, internalState *MtvRoomInternalState) brainy.Action {
 	return func(c brainy.Context, e brainy.Event) error {
-		setFirstTrackAsCurrentTrack(internalState)
+		event := e.(MtvRoomSeekEvent)
+		now := getNowFromSideEffect(ctx)
+
+		internalState.currentTrackSeekOffset += event.Position - getCurrentTrackPosition(internalState, now)
+		internalState.CurrentTrack.AlreadyElapsed = event.Position
 
 		return nil
 	}
@@ -199,6 +199,51 @@ func sendUserVoteForTrackAcknowledgementActivity(ctx workflow.Context, state sha
 	)
 }
 
+func sendUserUnvoteForTrackAcknowledgementActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.UserUnvoteForTrackAcknowledgement,
+		state,
+	)
+}
+
+func sendUserDownvoteTrackAcknowledgementActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.UserDownvoteTrackAcknowledgement,
+		state,
+	)
+}
+
+func sendNotifyTrackEvictedActivity(ctx workflow.Context, args activities_mtv.NotifyTrackEvictedArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.NotifyTrackEvictedActivity,
+		args,
+	)
+}
+
 func sendJoinActivity(ctx workflow.Context, args activities_mtv.MtvJoinCallbackRequestBody) {
 	options := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
@@ -273,3 +318,138 @@ func sendAcknowledgeUpdateTimeConstraintActivity(ctx workflow.Context, state sha
 		state,
 	)
 }
+
+func sendAcknowledgeVoteToSkipCurrentTrackActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeVoteToSkipCurrentTrack,
+		state,
+	)
+}
+
+func sendAcknowledgeUpdateQueueModeActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeUpdateQueueMode,
+		state,
+	)
+}
+
+func sendAcknowledgeSeekActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeSeek,
+		state,
+	)
+}
+
+func sendAcknowledgeUpdateSettingsActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeUpdateSettings,
+		state,
+	)
+}
+
+func sendAcknowledgeTransferOwnershipActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeTransferOwnership,
+		state,
+	)
+}
+
+func sendAcknowledgeKickUserActivity(ctx workflow.Context, args activities_mtv.AcknowledgeKickUserArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeKickUserActivity,
+		args,
+	)
+}
+
+func sendRejectJoinActivity(ctx workflow.Context, args activities_mtv.RejectJoinArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.RejectJoinActivity,
+		args,
+	)
+}
+
+func sendAcknowledgeInviteUserActivity(ctx workflow.Context, args activities_mtv.AcknowledgeInviteUserArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeInviteUserActivity,
+		args,
+	)
+}
+
+func sendAcknowledgeRevokeInvitationActivity(ctx workflow.Context, args activities_mtv.AcknowledgeRevokeInvitationArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeRevokeInvitationActivity,
+		args,
+	)
+}
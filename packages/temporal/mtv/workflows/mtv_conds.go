@@ -1,6 +1,8 @@
 package mtv
 
 import (
+	"fmt"
+
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	"github.com/Devessier/brainy"
 )
@@ -13,7 +15,7 @@ func userHasPermissionAndHasNextTrackToPlay(internalState *MtvRoomInternalState)
 		if userDoesNotHaveControlAndDelegationPermission {
 			return false
 		}
-		hasNextTrackToPlay := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+		hasNextTrackToPlay := internalState.HasNextTrackToPlay()
 
 		return hasNextTrackToPlay
 	}
@@ -29,7 +31,7 @@ func checkUserPermissionAndCanPlayCurrentTrack(internalState *MtvRoomInternalSta
 		}
 
 		hasReachedEndOfCurrentTrack := internalState.CurrentTrack.AlreadyElapsed == internalState.CurrentTrack.Duration
-		hasNextTrackToPlay := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+		hasNextTrackToPlay := internalState.HasNextTrackToPlay()
 		hasNoNextTrackToPlay := !hasNextTrackToPlay
 		canNotPlayCurrentTrack := hasReachedEndOfCurrentTrack && hasNoNextTrackToPlay
 		canPlayCurrentTrack := !canNotPlayCurrentTrack
@@ -49,7 +51,7 @@ func userHasPermissionToPauseCurrentTrack(internalState *MtvRoomInternalState) b
 func currentTrackEndedAndNextTrackIsReadyToBePlayed(internalState *MtvRoomInternalState) brainy.Cond {
 	return func(c brainy.Context, e brainy.Event) bool {
 		//We might need a delta ? between elapsed and maxDuration
-		nextTrackIsReadyToBePlayed := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+		nextTrackIsReadyToBePlayed := internalState.HasNextTrackToPlay()
 		//Remark:
 		//If of all initials tracks fetching fails or not initial tracks are eligible to be
 		//load as currentTrack during room creation, we expect the room to autoplay
@@ -61,6 +63,154 @@ func currentTrackEndedAndNextTrackIsReadyToBePlayed(internalState *MtvRoomIntern
 	}
 }
 
+func currentTrackEndedAndNextTrackIsNotReadyToBePlayed(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		nextTrackIsReadyToBePlayed := internalState.HasNextTrackToPlay()
+		currentTrackEnded := internalState.CurrentTrack.Duration == internalState.CurrentTrack.AlreadyElapsed
+
+		return currentTrackEnded && !nextTrackIsReadyToBePlayed
+	}
+}
+
+func userHasPermissionToUpdateQueueMode(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomUpdateQueueModeEvent)
+
+		return internalState.UserHasControlAndDelegationPermission(event.UserID)
+	}
+}
+
+func userHasPermissionToSeekCurrentTrack(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomSeekEvent)
+
+		userDoesNotHaveControlAndDelegationPermission := !internalState.UserHasControlAndDelegationPermission(event.UserID)
+		if userDoesNotHaveControlAndDelegationPermission {
+			return false
+		}
+
+		hasNoCurrentTrack := internalState.CurrentTrack.ID == ""
+		if hasNoCurrentTrack {
+			return false
+		}
+
+		positionIsInCurrentTrack := event.Position >= 0 && event.Position < internalState.CurrentTrack.Duration
+
+		return positionIsInCurrentTrack
+	}
+}
+
+func userIsRoomOwnerAndSettingsAreValid(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomUpdateSettingsEvent)
+
+		userIsNotRoomOwner := event.UserID != internalState.OwnerUserID
+		if userIsNotRoomOwner {
+			return false
+		}
+
+		updatedParams := internalState.initialParams.WithSettings(event.Settings)
+		if err := updatedParams.CheckParamsValidity(event.Now); err != nil {
+			fmt.Printf("update settings aborted: %v\n", err)
+			return false
+		}
+
+		return true
+	}
+}
+
+func userIsRoomOwnerAndNewOwnerIsInRoom(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomTransferOwnershipEvent)
+
+		userIsNotRoomOwner := event.UserID != internalState.OwnerUserID
+		if userIsNotRoomOwner {
+			return false
+		}
+
+		newOwnerIsAlreadyOwner := event.NewOwnerUserID == internalState.OwnerUserID
+		if newOwnerIsAlreadyOwner {
+			return false
+		}
+
+		return internalState.HasUser(event.NewOwnerUserID)
+	}
+}
+
+func userIsNotAllowedToJoinRoom(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomUserJoiningRoomEvent)
+
+		if internalState.UserIsBanned(event.User.UserID) {
+			return true
+		}
+
+		roomIsPrivate := !internalState.initialParams.IsOpen
+		userHasNotBeenInvited := !event.User.UserHasBeenInvited && !internalState.UserHasBeenInvited(event.User.UserID)
+
+		return roomIsPrivate && userHasNotBeenInvited
+	}
+}
+
+func userCanManageInvitations(internalState *MtvRoomInternalState, userID string) bool {
+	userIsRoomOwner := userID == internalState.OwnerUserID
+
+	return userIsRoomOwner || internalState.UserHasControlAndDelegationPermission(userID)
+}
+
+func userCanInviteUser(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomInviteUserEvent)
+
+		if !userCanManageInvitations(internalState, event.UserID) {
+			return false
+		}
+
+		invitedUserIsBanned := internalState.UserIsBanned(event.InvitedUserID)
+		invitedUserIsOwner := event.InvitedUserID == internalState.OwnerUserID
+		invitedUserHasAlreadyBeenInvited := internalState.UserHasBeenInvited(event.InvitedUserID)
+
+		return !invitedUserIsBanned && !invitedUserIsOwner && !invitedUserHasAlreadyBeenInvited
+	}
+}
+
+func userCanRevokeInvitation(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomRevokeInvitationEvent)
+
+		if !userCanManageInvitations(internalState, event.UserID) {
+			return false
+		}
+
+		return internalState.UserHasBeenInvited(event.InvitedUserID)
+	}
+}
+
+func userCanKickUser(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomKickUserEvent)
+
+		userIsRoomOwner := event.UserID == internalState.OwnerUserID
+		userHasControlAndDelegationPermission := internalState.UserHasControlAndDelegationPermission(event.UserID)
+		userCanNotModerate := !userIsRoomOwner && !userHasControlAndDelegationPermission
+		if userCanNotModerate {
+			return false
+		}
+
+		//The owner can not be kicked, neither can a user kick himself
+		kickedUserIsOwner := event.KickedUserID == internalState.OwnerUserID
+		kickedUserIsEmitter := event.KickedUserID == event.UserID
+		if kickedUserIsOwner || kickedUserIsEmitter {
+			return false
+		}
+
+		//A user who is not in the room can still be banned
+		kickedUserIsInRoom := internalState.HasUser(event.KickedUserID)
+
+		return kickedUserIsInRoom || event.Ban
+	}
+}
+
 func roomHasPositionAndTimeConstraint(internalState *MtvRoomInternalState) brainy.Cond {
 	return func(c brainy.Context, e brainy.Event) bool {
 		return internalState.initialParams.HasPhysicalAndTimeConstraints && internalState.initialParams.PhysicalAndTimeConstraints != nil
@@ -96,3 +246,34 @@ func userToUpdateExists(internalState *MtvRoomInternalState) brainy.Cond {
 		return doesUserToUpdateExist
 	}
 }
+
+func userCanVoteToSkipCurrentTrack(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomVoteToSkipCurrentTrackEvent)
+
+		return internalState.UserCanVoteToSkipCurrentTrack(event.UserID)
+	}
+}
+
+func skipVotesReachThresholdAndHasNextTrackToPlay(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		return internalState.SkipVotesReachThreshold() && internalState.HasNextTrackToPlay()
+	}
+}
+
+func userCanVoteToSkipAndVoteReachesThresholdAndHasNextTrackToPlay(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomVoteToSkipCurrentTrackEvent)
+
+		if !internalState.UserCanVoteToSkipCurrentTrack(event.UserID) {
+			return false
+		}
+
+		skipVotesCountWithEmitterVote := internalState.CountSkipVotes() + 1
+		requiredSkipVotesCount := internalState.initialParams.VoteToSkipThreshold.RequiredVotesCount(len(internalState.Users))
+		voteReachesThreshold := skipVotesCountWithEmitterVote >= requiredSkipVotesCount
+		hasNextTrackToPlay := internalState.HasNextTrackToPlay()
+
+		return voteReachesThreshold && hasNextTrackToPlay
+	}
+}
@@ -1,6 +1,8 @@
 package mtv
 
 import (
+	"time"
+
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
 	"github.com/Devessier/brainy"
@@ -39,6 +41,21 @@ func NewMtvRoomInitialTracksFetchedEvent(tracks []shared.TrackMetadata) MtvRoomI
 	}
 }
 
+type MtvRoomAutofillTrackFetchedEvent struct {
+	brainy.EventWithType
+
+	Track shared.TrackMetadata
+}
+
+func NewMtvRoomAutofillTrackFetchedEvent(track shared.TrackMetadata) MtvRoomAutofillTrackFetchedEvent {
+	return MtvRoomAutofillTrackFetchedEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomAutofillTrackFetched,
+		},
+		Track: track,
+	}
+}
+
 type MtvRoomUserLeavingRoomEvent struct {
 	brainy.EventWithType
 
@@ -73,6 +90,42 @@ func NewMtvRoomUserVoteForTrackEvent(userID string, trackID string) MtvRoomUserV
 	}
 }
 
+type MtvRoomUserUnvoteForTrackEvent struct {
+	brainy.EventWithType
+
+	UserID  string
+	TrackID string
+}
+
+func NewMtvRoomUserUnvoteForTrackEvent(userID string, trackID string) MtvRoomUserUnvoteForTrackEvent {
+	return MtvRoomUserUnvoteForTrackEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomUnvoteForTrackEvent,
+		},
+
+		UserID:  userID,
+		TrackID: trackID,
+	}
+}
+
+type MtvRoomUserDownvoteTrackEvent struct {
+	brainy.EventWithType
+
+	UserID  string
+	TrackID string
+}
+
+func NewMtvRoomUserDownvoteTrackEvent(userID string, trackID string) MtvRoomUserDownvoteTrackEvent {
+	return MtvRoomUserDownvoteTrackEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomDownvoteTrackEvent,
+		},
+
+		UserID:  userID,
+		TrackID: trackID,
+	}
+}
+
 type MtvRoomUserJoiningRoomEvent struct {
 	brainy.EventWithType
 
@@ -137,12 +190,16 @@ type MtvRoomSuggestedTracksFetchedEvent struct {
 	brainy.EventWithType
 
 	SuggestedTracksInformation []shared.TrackMetadata
+	RejectedTracks             []shared_mtv.RejectedTrack
+	HasVotedForQueuedTracks    bool
 	UserID                     string
 	DeviceID                   string
 }
 
 type NewMtvRoomSuggestedTracksFetchedEventArgs struct {
 	SuggestedTracksInformation []shared.TrackMetadata
+	RejectedTracks             []shared_mtv.RejectedTrack
+	HasVotedForQueuedTracks    bool
 	UserID                     string
 	DeviceID                   string
 }
@@ -154,6 +211,8 @@ func NewMtvRoomSuggestedTracksFetchedEvent(args NewMtvRoomSuggestedTracksFetched
 		},
 
 		SuggestedTracksInformation: args.SuggestedTracksInformation,
+		RejectedTracks:             args.RejectedTracks,
+		HasVotedForQueuedTracks:    args.HasVotedForQueuedTracks,
 		UserID:                     args.UserID,
 		DeviceID:                   args.DeviceID,
 	}
@@ -308,3 +367,192 @@ func NewMtvHandlerTimeConstraintTimerExpirationEvent(args NewMtvRoomTimeConstrai
 		TimeConstraintValue: args.TimeConstraintValue,
 	}
 }
+
+type MtvRoomVoteToSkipCurrentTrackEvent struct {
+	brainy.EventWithType
+
+	UserID string
+}
+
+type NewMtvRoomVoteToSkipCurrentTrackEventArgs struct {
+	UserID string
+}
+
+func NewMtvRoomVoteToSkipCurrentTrackEvent(args NewMtvRoomVoteToSkipCurrentTrackEventArgs) MtvRoomVoteToSkipCurrentTrackEvent {
+	return MtvRoomVoteToSkipCurrentTrackEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomVoteToSkipCurrentTrack,
+		},
+
+		UserID: args.UserID,
+	}
+}
+
+type MtvRoomUpdateQueueModeEvent struct {
+	brainy.EventWithType
+
+	UserID    string
+	QueueMode shared_mtv.MtvQueueModes
+}
+
+type NewMtvRoomUpdateQueueModeEventArgs struct {
+	UserID    string
+	QueueMode shared_mtv.MtvQueueModes
+}
+
+func NewMtvRoomUpdateQueueModeEvent(args NewMtvRoomUpdateQueueModeEventArgs) MtvRoomUpdateQueueModeEvent {
+	return MtvRoomUpdateQueueModeEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomUpdateQueueMode,
+		},
+
+		UserID:    args.UserID,
+		QueueMode: args.QueueMode,
+	}
+}
+
+type MtvRoomSeekEvent struct {
+	brainy.EventWithType
+
+	UserID   string
+	Position time.Duration
+}
+
+type NewMtvRoomSeekEventArgs struct {
+	UserID   string
+	Position time.Duration
+}
+
+func NewMtvRoomSeekEvent(args NewMtvRoomSeekEventArgs) MtvRoomSeekEvent {
+	return MtvRoomSeekEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomSeek,
+		},
+
+		UserID:   args.UserID,
+		Position: args.Position,
+	}
+}
+
+type MtvRoomUpdateSettingsEvent struct {
+	brainy.EventWithType
+
+	UserID   string
+	Settings shared_mtv.MtvRoomSettings
+	// Settings are validated against the time the signal has been received
+	Now time.Time
+}
+
+type NewMtvRoomUpdateSettingsEventArgs struct {
+	UserID   string
+	Settings shared_mtv.MtvRoomSettings
+	Now      time.Time
+}
+
+func NewMtvRoomUpdateSettingsEvent(args NewMtvRoomUpdateSettingsEventArgs) MtvRoomUpdateSettingsEvent {
+	return MtvRoomUpdateSettingsEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomUpdateSettings,
+		},
+
+		UserID:   args.UserID,
+		Settings: args.Settings,
+		Now:      args.Now,
+	}
+}
+
+type MtvRoomTransferOwnershipEvent struct {
+	brainy.EventWithType
+
+	UserID         string
+	NewOwnerUserID string
+}
+
+type NewMtvRoomTransferOwnershipEventArgs struct {
+	UserID         string
+	NewOwnerUserID string
+}
+
+func NewMtvRoomTransferOwnershipEvent(args NewMtvRoomTransferOwnershipEventArgs) MtvRoomTransferOwnershipEvent {
+	return MtvRoomTransferOwnershipEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomTransferOwnership,
+		},
+
+		UserID:         args.UserID,
+		NewOwnerUserID: args.NewOwnerUserID,
+	}
+}
+
+type MtvRoomKickUserEvent struct {
+	brainy.EventWithType
+
+	UserID       string
+	KickedUserID string
+	// A banned user is kicked and can not join the room anymore
+	Ban bool
+}
+
+type NewMtvRoomKickUserEventArgs struct {
+	UserID       string
+	KickedUserID string
+	Ban          bool
+}
+
+func NewMtvRoomKickUserEvent(args NewMtvRoomKickUserEventArgs) MtvRoomKickUserEvent {
+	return MtvRoomKickUserEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomKickUser,
+		},
+
+		UserID:       args.UserID,
+		KickedUserID: args.KickedUserID,
+		Ban:          args.Ban,
+	}
+}
+
+type MtvRoomInviteUserEvent struct {
+	brainy.EventWithType
+
+	UserID        string
+	InvitedUserID string
+}
+
+type NewMtvRoomInviteUserEventArgs struct {
+	UserID        string
+	InvitedUserID string
+}
+
+func NewMtvRoomInviteUserEvent(args NewMtvRoomInviteUserEventArgs) MtvRoomInviteUserEvent {
+	return MtvRoomInviteUserEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomInviteUser,
+		},
+
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
+
+type MtvRoomRevokeInvitationEvent struct {
+	brainy.EventWithType
+
+	UserID        string
+	InvitedUserID string
+}
+
+type NewMtvRoomRevokeInvitationEventArgs struct {
+	UserID        string
+	InvitedUserID string
+}
+
+func NewMtvRoomRevokeInvitationEvent(args NewMtvRoomRevokeInvitationEventArgs) MtvRoomRevokeInvitationEvent {
+	return MtvRoomRevokeInvitationEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomRevokeInvitation,
+		},
+
+		UserID:        args.UserID,
+		InvitedUserID: args.InvitedUserID,
+	}
+}
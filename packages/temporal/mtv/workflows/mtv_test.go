@@ -3,6 +3,7 @@ package mtv
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -72,6 +73,102 @@ func (s *UnitTestSuite) getUsersList() []shared_mtv.ExposedInternalStateUserList
 	return usersList
 }
 
+func (s *UnitTestSuite) getHistory(page int, limit int) shared_mtv.MtvRoomExposedHistory {
+	var history shared_mtv.MtvRoomExposedHistory
+
+	res, err := s.env.QueryWorkflow(shared_mtv.MtvGetHistoryQuery, page, limit)
+	s.NoError(err)
+
+	err = res.Get(&history)
+	s.NoError(err)
+
+	return history
+}
+
+func (s *UnitTestSuite) getPendingInvitations() []shared_mtv.MtvRoomInvitation {
+	var pendingInvitations []shared_mtv.MtvRoomInvitation
+
+	res, err := s.env.QueryWorkflow(shared_mtv.MtvGetPendingInvitationsQuery)
+	s.NoError(err)
+
+	err = res.Get(&pendingInvitations)
+	s.NoError(err)
+
+	return pendingInvitations
+}
+
+// tracksWithoutAddedAt checks every track has an addition date and removes it,
+// as it depends on the mocked time at which the track has been added.
+func (s *UnitTestSuite) tracksWithoutAddedAt(tracks []shared_mtv.TrackMetadataWithScoreWithDuration) []shared_mtv.TrackMetadataWithScoreWithDuration {
+	tracksWithoutAddedAt := make([]shared_mtv.TrackMetadataWithScoreWithDuration, 0, len(tracks))
+
+	for _, track := range tracks {
+		_, err := time.Parse(time.RFC3339, track.AddedAt)
+		s.NoError(err)
+
+		track.AddedAt = ""
+		tracksWithoutAddedAt = append(tracksWithoutAddedAt, track)
+	}
+
+	return tracksWithoutAddedAt
+}
+
+func (s *UnitTestSuite) emitUpdateQueueModeSignal(args shared_mtv.NewUpdateQueueModeSignalArgs) {
+	fmt.Println("-----EMIT UPDATE QUEUE MODE CALLED IN TEST-----")
+	signal := shared_mtv.NewUpdateQueueModeSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitSeekSignal(args shared_mtv.NewSeekSignalArgs) {
+	fmt.Println("-----EMIT SEEK CALLED IN TEST-----")
+	signal := shared_mtv.NewSeekSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitUpdateSettingsSignal(args shared_mtv.NewUpdateSettingsSignalArgs) {
+	fmt.Println("-----EMIT UPDATE SETTINGS CALLED IN TEST-----")
+	signal := shared_mtv.NewUpdateSettingsSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitTransferOwnershipSignal(args shared_mtv.NewTransferOwnershipSignalArgs) {
+	fmt.Println("-----EMIT TRANSFER OWNERSHIP CALLED IN TEST-----")
+	signal := shared_mtv.NewTransferOwnershipSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitKickUserSignal(args shared_mtv.NewKickUserSignalArgs) {
+	fmt.Println("-----EMIT KICK USER CALLED IN TEST-----")
+	signal := shared_mtv.NewKickUserSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitBanUserSignal(args shared_mtv.NewBanUserSignalArgs) {
+	fmt.Println("-----EMIT BAN USER CALLED IN TEST-----")
+	signal := shared_mtv.NewBanUserSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitInviteUserSignal(args shared_mtv.NewInviteUserSignalArgs) {
+	fmt.Println("-----EMIT INVITE USER CALLED IN TEST-----")
+	signal := shared_mtv.NewInviteUserSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitRevokeInvitationSignal(args shared_mtv.NewRevokeInvitationSignalArgs) {
+	fmt.Println("-----EMIT REVOKE INVITATION CALLED IN TEST-----")
+	signal := shared_mtv.NewRevokeInvitationSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
 func (s *UnitTestSuite) emitUnkownSignal() {
 	fmt.Println("-----EMIT UNKOWN SIGNAL CALLED IN TEST-----")
 	unkownSignal := struct {
@@ -121,6 +218,20 @@ func (s *UnitTestSuite) emitVoteSignal(args shared_mtv.NewVoteForTrackSignalArgs
 	s.env.SignalWorkflow(shared_mtv.SignalChannelName, voteForTrackSignal)
 }
 
+func (s *UnitTestSuite) emitUnvoteSignal(args shared_mtv.NewUnvoteForTrackSignalArgs) {
+	fmt.Println("-----EMIT UNVOTE TRACK CALLED IN TEST-----")
+	unvoteForTrackSignal := shared_mtv.NewUnvoteForTrackSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, unvoteForTrackSignal)
+}
+
+func (s *UnitTestSuite) emitDownvoteSignal(args shared_mtv.NewDownvoteTrackSignalArgs) {
+	fmt.Println("-----EMIT DOWNVOTE TRACK CALLED IN TEST-----")
+	downvoteTrackSignal := shared_mtv.NewDownvoteTrackSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, downvoteTrackSignal)
+}
+
 func (s *UnitTestSuite) emitJoinSignal(args shared_mtv.NewJoinSignalArgs) {
 	fmt.Println("-----EMIT JOIN CALLED IN TEST-----")
 	signal := shared_mtv.NewJoinSignal(shared_mtv.NewJoinSignalArgs{
@@ -194,6 +305,13 @@ func (s *UnitTestSuite) emitGoToNextTrackSignal(args shared_mtv.NewGoToNextTrack
 	s.env.SignalWorkflow(shared_mtv.SignalChannelName, goToNextTrackSignal)
 }
 
+func (s *UnitTestSuite) emitVoteToSkipCurrentTrackSignal(args shared_mtv.NewVoteToSkipCurrentTrackSignalArgs) {
+	fmt.Println("-----EMIT VOTE TO SKIP CURRENT TRACK IN TEST-----")
+	voteToSkipCurrentTrackSignal := shared_mtv.NewVoteToSkipCurrentTrackSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, voteToSkipCurrentTrackSignal)
+}
+
 func (s *UnitTestSuite) initTestEnv() (func(), func(callback func(), durationToAdd time.Duration)) {
 	var temporalTemporality time.Duration
 	now := time.Now()
@@ -291,7 +409,7 @@ func (s *UnitTestSuite) Test_PlayThenPauseTrack() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -483,7 +601,7 @@ func (s *UnitTestSuite) Test_JoinCreatedRoom() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -650,7 +768,7 @@ func (s *UnitTestSuite) Test_ChangeUserEmittingDevice() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.ChangeUserEmittingDeviceActivity,
 		mock.Anything,
@@ -829,7 +947,7 @@ func (s *UnitTestSuite) Test_GoToNextTrack() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -970,7 +1088,7 @@ func (s *UnitTestSuite) Test_UserLeaveRoom() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		mock.Anything,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -1075,7 +1193,7 @@ func (s *UnitTestSuite) Test_PlayActivityIsNotCalledWhenTryingToPlayTheLastTrack
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -1212,7 +1330,7 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	// Mock suggested and accepted tracks information fetching
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivityAndForwardInitiator,
@@ -1288,7 +1406,8 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 					},
 					Score: 1,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1301,7 +1420,8 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 
 					Score: 1,
 				},
-				Duration: tracksToSuggestMetadata[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggestMetadata[0].Duration.Milliseconds(),
+				AddedByUserID: suggesterUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1313,11 +1433,12 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggestMetadata[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggestMetadata[1].Duration.Milliseconds(),
+				AddedByUserID: suggesterUserID,
 			},
 		}
 
-		s.Equal(expectedMtvStateTracks, mtvState.Tracks)
+		s.Equal(expectedMtvStateTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, assertSuggestedTracksHaveBeenAcceptedDelay)
 
 	secondSuggestTracksSignalDelay := defaultDuration
@@ -1344,7 +1465,8 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 					},
 					Score: 1,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1357,7 +1479,8 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 
 					Score: 1,
 				},
-				Duration: tracksToSuggestMetadata[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggestMetadata[0].Duration.Milliseconds(),
+				AddedByUserID: suggesterUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1369,12 +1492,13 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggestMetadata[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggestMetadata[1].Duration.Milliseconds(),
+				AddedByUserID: suggesterUserID,
 			},
 		}
 
 		s.Len(mtvState.Tracks, 3)
-		s.Equal(expectedMtvStateTracks, mtvState.Tracks)
+		s.Equal(expectedMtvStateTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, assertDuplicateSuggestedTrackHasNotBeenAcceptedDelay)
 
 	thirdSuggestTracksSignalDelay := defaultDuration
@@ -1401,7 +1525,8 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 					},
 					Score: 2,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1414,7 +1539,8 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 
 					Score: 1,
 				},
-				Duration: tracksToSuggestMetadata[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggestMetadata[0].Duration.Milliseconds(),
+				AddedByUserID: suggesterUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1426,12 +1552,13 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggestMetadata[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggestMetadata[1].Duration.Milliseconds(),
+				AddedByUserID: suggesterUserID,
 			},
 		}
 
 		s.Len(mtvState.Tracks, 3)
-		s.Equal(expectedMtvStateTracks, mtvState.Tracks)
+		s.Equal(expectedMtvStateTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, assertDuplicateFromTracksListSuggestedTrackHasNotBeenAcceptedDelay)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
@@ -1441,7 +1568,7 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_TracksSuggestedBeforePreviousSuggestedTracksInformationHaveBeenFetchedAreNotLost() {
+func (s *UnitTestSuite) Test_UnresolvedSuggestedTracksAreListedInAcknowledgement() {
 	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
@@ -1451,166 +1578,62 @@ func (s *UnitTestSuite) Test_TracksSuggestedBeforePreviousSuggestedTracksInforma
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	tracksExposedMetadata := []shared_mtv.TrackMetadataWithScoreWithDuration{
-		{
-			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-				TrackMetadata: shared.TrackMetadata{
-					ID:         tracks[0].ID,
-					Title:      tracks[0].Title,
-					ArtistName: tracks[0].ArtistName,
-					Duration:   0,
-				},
-				Score: 1,
-			},
-			Duration: tracks[0].Duration.Milliseconds(),
-		},
-		{
-			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-				TrackMetadata: shared.TrackMetadata{
-					ID:         tracks[1].ID,
-					Title:      tracks[1].Title,
-					ArtistName: tracks[1].ArtistName,
-					Duration:   0,
-				},
-				Score: 1,
-			},
-			Duration: tracks[1].Duration.Milliseconds(),
-		},
 	}
-	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
-	firstTracksIDsToSuggest := []string{
-		faker.UUIDHyphenated(),
+	tracksIDs := []string{tracks[0].ID}
+	tracksIDsToSuggest := []string{
 		faker.UUIDHyphenated(),
-	}
-	secondTracksIDsToSuggest := []string{
 		faker.UUIDHyphenated(),
 		faker.UUIDHyphenated(),
 	}
 	suggesterUserID := faker.UUIDHyphenated()
 	suggesterDeviceID := faker.UUIDHyphenated()
-	firstTracksToSuggestMetadata := []shared.TrackMetadata{
-		{
-			ID:         firstTracksIDsToSuggest[0],
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-		{
-			ID:         firstTracksIDsToSuggest[1],
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	secondTracksToSuggestMetadata := []shared.TrackMetadata{
-		{
-			ID:         secondTracksIDsToSuggest[0],
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
+	//Only the first suggested track can be fetched
+	tracksToSuggestMetadata := []shared.TrackMetadata{
 		{
-			ID:         secondTracksIDsToSuggest[1],
+			ID:         tracksIDsToSuggest[0],
 			Title:      faker.Word(),
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-	firstTracksToSuggestExposedMetadata := []shared_mtv.TrackMetadataWithScoreWithDuration{
-		{
-			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-				TrackMetadata: shared.TrackMetadata{
-					ID:         firstTracksToSuggestMetadata[0].ID,
-					Title:      firstTracksToSuggestMetadata[0].Title,
-					ArtistName: firstTracksToSuggestMetadata[0].ArtistName,
-					Duration:   0,
-				},
-				Score: 1,
-			},
-			Duration: firstTracksToSuggestMetadata[0].Duration.Milliseconds(),
-		},
-		{
-			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-				TrackMetadata: shared.TrackMetadata{
-					ID:         firstTracksToSuggestMetadata[1].ID,
-					Title:      firstTracksToSuggestMetadata[1].Title,
-					ArtistName: firstTracksToSuggestMetadata[1].ArtistName,
-					Duration:   0,
-				},
-				Score: 1,
-			},
-			Duration: firstTracksToSuggestMetadata[1].Duration.Milliseconds(),
-		},
-	}
-	secondTracksToSuggestExposedMetadata := []shared_mtv.TrackMetadataWithScoreWithDuration{
+	expectedRejectedTracks := []shared_mtv.RejectedTrack{
 		{
-			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-				TrackMetadata: shared.TrackMetadata{
-					ID:         secondTracksToSuggestMetadata[0].ID,
-					Title:      secondTracksToSuggestMetadata[0].Title,
-					ArtistName: secondTracksToSuggestMetadata[0].ArtistName,
-					Duration:   0,
-				},
-				Score: 1,
-			},
-			Duration: secondTracksToSuggestMetadata[0].Duration.Milliseconds(),
+			TrackID: tracksIDsToSuggest[1],
+			Reason:  shared_mtv.TrackRejectionReasonNotFound,
 		},
 		{
-			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-				TrackMetadata: shared.TrackMetadata{
-					ID:         secondTracksToSuggestMetadata[1].ID,
-					Title:      secondTracksToSuggestMetadata[1].Title,
-					ArtistName: secondTracksToSuggestMetadata[1].ArtistName,
-					Duration:   0,
-				},
-				Score: 1,
-			},
-			Duration: secondTracksToSuggestMetadata[1].Duration.Milliseconds(),
+			TrackID: tracksIDsToSuggest[2],
+			Reason:  shared_mtv.TrackRejectionReasonDurationParseError,
 		},
 	}
-	params, _ := getWorkflowInitParams(tracksIDs, 1)
-
-	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
-	defaultDuration := 1 * time.Millisecond
 
-	defer resetMock()
-
-	// Mock first tracks information fetching
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
-
-	// Mock suggested and accepted tracks information fetching
-	// Make the first mock of the activity return a long time after the next one
-	// to simulate a race condition.
-	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
-		mock.Anything,
-		firstTracksIDsToSuggest,
-		suggesterUserID,
-		suggesterDeviceID,
-	).Return(activities.FetchedTracksInformationWithInitiator{
-		Metadata: firstTracksToSuggestMetadata,
-		UserID:   suggesterUserID,
-		DeviceID: suggesterDeviceID,
-	}, nil).Once().After(10 * time.Second)
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivityAndForwardInitiator,
 		mock.Anything,
-		secondTracksIDsToSuggest,
+		tracksIDsToSuggest,
 		suggesterUserID,
 		suggesterDeviceID,
 	).Return(activities.FetchedTracksInformationWithInitiator{
-		Metadata: secondTracksToSuggestMetadata,
+		Metadata: tracksToSuggestMetadata,
+		Statuses: []shared.TrackFetchResult{
+			{
+				TrackID: tracksIDsToSuggest[0],
+				Status:  shared.TrackFetchStatusFound,
+			},
+			{
+				TrackID: tracksIDsToSuggest[1],
+				Status:  shared.TrackFetchStatusNotFound,
+			},
+			{
+				TrackID: tracksIDsToSuggest[2],
+				Status:  shared.TrackFetchStatusDurationParseError,
+			},
+		},
 		UserID:   suggesterUserID,
 		DeviceID: suggesterDeviceID,
 	}, nil).Once()
@@ -1624,62 +1647,47 @@ func (s *UnitTestSuite) Test_TracksSuggestedBeforePreviousSuggestedTracksInforma
 		a.NotifySuggestOrVoteUpdateActivity,
 		mock.Anything,
 		mock.Anything,
-	).Return(nil).Times(2)
+	).Return(nil).Once()
 	s.env.OnActivity(
 		a.AcknowledgeTracksSuggestion,
 		mock.Anything,
-		mock.Anything,
-	).Return(nil).Twice()
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeTracksSuggestionArgs) bool {
+			return args.DeviceID == suggesterDeviceID && reflect.DeepEqual(expectedRejectedTracks, args.RejectedTracks)
+		}),
+	).Return(nil).Once()
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+	defaultDuration := 1 * time.Millisecond
+
+	defer resetMock()
 
 	joinSuggesterUser := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
 			DeviceID:           suggesterDeviceID,
 			UserID:             suggesterUserID,
 			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, joinSuggesterUser)
-
-	firstSuggestTracksSignalDelay := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
-			TracksToSuggest: firstTracksIDsToSuggest,
-			UserID:          suggesterUserID,
-			DeviceID:        suggesterDeviceID,
 		})
-	}, firstSuggestTracksSignalDelay)
+	}, joinSuggesterUser)
 
-	secondSuggestTracksSignalDelay := defaultDuration
+	suggestTracksSignalDelay := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
-			TracksToSuggest: secondTracksIDsToSuggest,
+			TracksToSuggest: tracksIDsToSuggest,
 			UserID:          suggesterUserID,
 			DeviceID:        suggesterDeviceID,
 		})
-	}, secondSuggestTracksSignalDelay)
-
-	assertSecondSuggestedTrackHasBeenAcceptedDelay := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
-		initialTrackAndSecondTracksToSuggest := append([]shared_mtv.TrackMetadataWithScoreWithDuration{}, tracksExposedMetadata[1])
-		initialTrackAndSecondTracksToSuggest = append(initialTrackAndSecondTracksToSuggest, secondTracksToSuggestExposedMetadata...)
+	}, suggestTracksSignalDelay)
 
-		s.Len(mtvState.Tracks, 3)
-		s.Equal(initialTrackAndSecondTracksToSuggest, mtvState.Tracks)
-	}, assertSecondSuggestedTrackHasBeenAcceptedDelay)
-
-	assertAllSuggestedTracksHaveBeenAcceptedAfterEveryFetchingHasEndedDelay := 15 * time.Second
+	assertOnlyFetchedTrackHasBeenAcceptedDelay := defaultDuration * 20
 	registerDelayedCallbackWrapper(func() {
-		allSuggestedTracks := append([]shared_mtv.TrackMetadataWithScoreWithDuration{}, tracksExposedMetadata[1])
-		allSuggestedTracks = append(allSuggestedTracks, secondTracksToSuggestExposedMetadata...)
-		allSuggestedTracks = append(allSuggestedTracks, firstTracksToSuggestExposedMetadata...)
-
 		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		s.Len(mtvState.Tracks, 5)
-		s.Equal(allSuggestedTracks, mtvState.Tracks)
-	}, assertAllSuggestedTracksHaveBeenAcceptedAfterEveryFetchingHasEndedDelay)
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(tracksToSuggestMetadata[0].ID, mtvState.Tracks[0].ID)
+	}, assertOnlyFetchedTrackHasBeenAcceptedDelay)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -1688,14 +1696,8 @@ func (s *UnitTestSuite) Test_TracksSuggestedBeforePreviousSuggestedTracksInforma
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_VoteForTrack() {
-	var (
-		a *activities_mtv.Activities
-
-		fakeUserID      = faker.UUIDHyphenated()
-		joiningUserID   = faker.UUIDHyphenated()
-		joiningDeviceID = faker.UUIDHyphenated()
-	)
+func (s *UnitTestSuite) Test_TracksIDsDesignatingTheSameTrackAreNotSuggestedTwice() {
+	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -1711,52 +1713,286 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-
-	tracksToSuggest := []shared.TrackMetadata{
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	suggestedTrackMetadata := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	suggesterUserID := faker.UUIDHyphenated()
+	suggesterDeviceID := faker.UUIDHyphenated()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	//The current and the queued tracks are not fetched again
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		[]string{suggestedTrackMetadata.ID},
+		suggesterUserID,
+		suggesterDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: []shared.TrackMetadata{suggestedTrackMetadata},
+		Statuses: []shared.TrackFetchResult{
+			{
+				TrackID: suggestedTrackMetadata.ID,
+				Status:  shared.TrackFetchStatusFound,
+			},
+		},
+		UserID:   suggesterUserID,
+		DeviceID: suggesterDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestion,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+	defaultDuration := 1 * time.Millisecond
+
+	defer resetMock()
+
+	joinSuggesterUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			DeviceID:           suggesterDeviceID,
+			UserID:             suggesterUserID,
+			UserHasBeenInvited: false,
+		})
+	}, joinSuggesterUser)
+
+	suggestTracksSignalDelay := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{
+				"youtube:" + tracks[0].ID,
+				"youtube:" + tracks[1].ID,
+				suggestedTrackMetadata.ID,
+				"youtube:" + suggestedTrackMetadata.ID,
+			},
+			UserID:   suggesterUserID,
+			DeviceID: suggesterDeviceID,
+		})
+	}, suggestTracksSignalDelay)
+
+	assertQueuedTrackHasBeenVotedForDelay := defaultDuration * 20
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Len(mtvState.Tracks, 2)
+		s.Equal(tracks[1].ID, mtvState.Tracks[0].ID)
+		s.Equal(2, mtvState.Tracks[0].Score)
+		s.Equal(suggestedTrackMetadata.ID, mtvState.Tracks[1].ID)
+	}, assertQueuedTrackHasBeenVotedForDelay)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_TracksSuggestedBeforePreviousSuggestedTracksInformationHaveBeenFetchedAreNotLost() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
 		{
 			ID:         faker.UUIDHyphenated(),
 			Title:      faker.Word(),
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
-		}, {
+		},
+		{
 			ID:         faker.UUIDHyphenated(),
 			Title:      faker.Word(),
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	firstTracksIDsToSuggest := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	secondTracksIDsToSuggest := []string{
+		faker.UUIDHyphenated(),
+		faker.UUIDHyphenated(),
+	}
+	suggesterUserID := faker.UUIDHyphenated()
+	suggesterDeviceID := faker.UUIDHyphenated()
+	firstTracksToSuggestMetadata := []shared.TrackMetadata{
 		{
-			ID:         faker.UUIDHyphenated(),
+			ID:         firstTracksIDsToSuggest[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         firstTracksIDsToSuggest[1],
 			Title:      faker.Word(),
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
+	secondTracksToSuggestMetadata := []shared.TrackMetadata{
+		{
+			ID:         secondTracksIDsToSuggest[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         secondTracksIDsToSuggest[1],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	firstTracksToSuggestExposedMetadata := []shared_mtv.TrackMetadataWithScoreWithDuration{
+		{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         firstTracksToSuggestMetadata[0].ID,
+					Title:      firstTracksToSuggestMetadata[0].Title,
+					ArtistName: firstTracksToSuggestMetadata[0].ArtistName,
+					Duration:   0,
+				},
+				Score: 1,
+			},
+			Duration:      firstTracksToSuggestMetadata[0].Duration.Milliseconds(),
+			AddedByUserID: suggesterUserID,
+		},
+		{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         firstTracksToSuggestMetadata[1].ID,
+					Title:      firstTracksToSuggestMetadata[1].Title,
+					ArtistName: firstTracksToSuggestMetadata[1].ArtistName,
+					Duration:   0,
+				},
+				Score: 1,
+			},
+			Duration:      firstTracksToSuggestMetadata[1].Duration.Milliseconds(),
+			AddedByUserID: suggesterUserID,
+		},
+	}
+	secondTracksToSuggestExposedMetadata := []shared_mtv.TrackMetadataWithScoreWithDuration{
+		{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         secondTracksToSuggestMetadata[0].ID,
+					Title:      secondTracksToSuggestMetadata[0].Title,
+					ArtistName: secondTracksToSuggestMetadata[0].ArtistName,
+					Duration:   0,
+				},
+				Score: 1,
+			},
+			Duration:      secondTracksToSuggestMetadata[0].Duration.Milliseconds(),
+			AddedByUserID: suggesterUserID,
+		},
+		{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         secondTracksToSuggestMetadata[1].ID,
+					Title:      secondTracksToSuggestMetadata[1].Title,
+					ArtistName: secondTracksToSuggestMetadata[1].ArtistName,
+					Duration:   0,
+				},
+				Score: 1,
+			},
+			Duration:      secondTracksToSuggestMetadata[1].Duration.Milliseconds(),
+			AddedByUserID: suggesterUserID,
+		},
+	}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	tracksExposedMetadata := []shared_mtv.TrackMetadataWithScoreWithDuration{
+		{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         tracks[0].ID,
+					Title:      tracks[0].Title,
+					ArtistName: tracks[0].ArtistName,
+					Duration:   0,
+				},
+				Score: 1,
+			},
+			Duration:      tracks[0].Duration.Milliseconds(),
+			AddedByUserID: params.RoomCreatorUserID,
+		},
+		{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         tracks[1].ID,
+					Title:      tracks[1].Title,
+					ArtistName: tracks[1].ArtistName,
+					Duration:   0,
+				},
+				Score: 1,
+			},
+			Duration:      tracks[1].Duration.Milliseconds(),
+			AddedByUserID: params.RoomCreatorUserID,
+		},
+	}
 
-	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-
-	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+	defaultDuration := 1 * time.Millisecond
 
 	defer resetMock()
 
+	// Mock first tracks information fetching
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+
+	// Mock suggested and accepted tracks information fetching
+	// Make the first mock of the activity return a long time after the next one
+	// to simulate a race condition.
 	s.env.OnActivity(
-		a.CreationAcknowledgementActivity,
-		mock.Anything,
+		activities.FetchTracksInformationActivityAndForwardInitiator,
 		mock.Anything,
-	).Return(nil).Once()
+		firstTracksIDsToSuggest,
+		suggesterUserID,
+		suggesterDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: firstTracksToSuggestMetadata,
+		UserID:   suggesterUserID,
+		DeviceID: suggesterDeviceID,
+	}, nil).Once().After(10 * time.Second)
 	s.env.OnActivity(
-		a.JoinActivity,
-		mock.Anything,
+		activities.FetchTracksInformationActivityAndForwardInitiator,
 		mock.Anything,
-	).Return(nil).Once()
+		secondTracksIDsToSuggest,
+		suggesterUserID,
+		suggesterDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: secondTracksToSuggestMetadata,
+		UserID:   suggesterUserID,
+		DeviceID: suggesterDeviceID,
+	}, nil).Once()
+
 	s.env.OnActivity(
-		a.UserVoteForTrackAcknowledgement,
+		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
@@ -1764,32 +2000,172 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 		a.NotifySuggestOrVoteUpdateActivity,
 		mock.Anything,
 		mock.Anything,
-	).Return(nil).Times(1)
+	).Return(nil).Times(2)
 	s.env.OnActivity(
-		a.AcknowledgeTracksSuggestionFail,
+		a.AcknowledgeTracksSuggestion,
 		mock.Anything,
 		mock.Anything,
-	).Return(nil).Times(1)
+	).Return(nil).Twice()
 
-	//Verify that creator has voted for the initialTracks and that he cannot vote or vote by suggest again
-	checkCreatorTracksVotedFor := defaultDuration
+	joinSuggesterUser := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:   params.RoomCreatorUserID,
-			DeviceID: creatorDeviceID,
-			TracksVotedFor: []string{
-				tracks[1].ID,
-			},
-			HasControlAndDelegationPermission: true,
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           suggesterDeviceID,
+			UserID:             suggesterUserID,
+			UserHasBeenInvited: false,
 		}
+		s.emitJoinSignal(args)
+	}, joinSuggesterUser)
 
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-	}, checkCreatorTracksVotedFor)
-
-	//Here we're checking that the initialTracks score is not incremented
-	//As we cannot suggest/suggest-into-vote for the currentTrack or a track a user has already voted for
+	firstSuggestTracksSignalDelay := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: firstTracksIDsToSuggest,
+			UserID:          suggesterUserID,
+			DeviceID:        suggesterDeviceID,
+		})
+	}, firstSuggestTracksSignalDelay)
+
+	secondSuggestTracksSignalDelay := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: secondTracksIDsToSuggest,
+			UserID:          suggesterUserID,
+			DeviceID:        suggesterDeviceID,
+		})
+	}, secondSuggestTracksSignalDelay)
+
+	assertSecondSuggestedTrackHasBeenAcceptedDelay := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+		initialTrackAndSecondTracksToSuggest := append([]shared_mtv.TrackMetadataWithScoreWithDuration{}, tracksExposedMetadata[1])
+		initialTrackAndSecondTracksToSuggest = append(initialTrackAndSecondTracksToSuggest, secondTracksToSuggestExposedMetadata...)
+
+		s.Len(mtvState.Tracks, 3)
+		s.Equal(initialTrackAndSecondTracksToSuggest, s.tracksWithoutAddedAt(mtvState.Tracks))
+	}, assertSecondSuggestedTrackHasBeenAcceptedDelay)
+
+	assertAllSuggestedTracksHaveBeenAcceptedAfterEveryFetchingHasEndedDelay := 15 * time.Second
+	registerDelayedCallbackWrapper(func() {
+		allSuggestedTracks := append([]shared_mtv.TrackMetadataWithScoreWithDuration{}, tracksExposedMetadata[1])
+		allSuggestedTracks = append(allSuggestedTracks, secondTracksToSuggestExposedMetadata...)
+		allSuggestedTracks = append(allSuggestedTracks, firstTracksToSuggestExposedMetadata...)
+
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Len(mtvState.Tracks, 5)
+		s.Equal(allSuggestedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
+	}, assertAllSuggestedTracksHaveBeenAcceptedAfterEveryFetchingHasEndedDelay)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_VoteForTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		fakeUserID      = faker.UUIDHyphenated()
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksToSuggest := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		}, {
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserVoteForTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(1)
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestionFail,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(1)
+
+	//Verify that creator has voted for the initialTracks and that he cannot vote or vote by suggest again
+	checkCreatorTracksVotedFor := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: creatorDeviceID,
+			TracksVotedFor: []string{
+				tracks[1].ID,
+			},
+			HasControlAndDelegationPermission: true,
+		}
+
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, checkCreatorTracksVotedFor)
+
+	//Here we're checking that the initialTracks score is not incremented
+	//As we cannot suggest/suggest-into-vote for the currentTrack or a track a user has already voted for
 	//Here the first initial track is the currentTrack and the other one from the queue is counted
 	//As creator voted for
 	emitSuggestForAnInitialTrack := defaultDuration
@@ -1816,11 +2192,12 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
-		s.Equal(expected, mtvState.Tracks)
+		s.Equal(expected, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkForSuggestFails)
 
 	emitJoinSignal := defaultDuration
@@ -1874,7 +2251,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 2,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1886,7 +2264,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[0].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1898,11 +2277,12 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggest[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[1].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 		}
 
-		s.Equal(expected, mtvState.Tracks)
+		s.Equal(expected, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkForSuggest)
 
 	emitVoteForCreatorForAlreadyVoted := defaultDuration
@@ -1944,7 +2324,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 2,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1956,7 +2337,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[0].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -1968,11 +2350,12 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggest[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[1].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 		}
 
-		s.Equal(expected, mtvState.Tracks)
+		s.Equal(expected, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkNothingHappened)
 
 	emitVoteForCreatorForNotVotedTrack := defaultDuration
@@ -1998,7 +2381,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 2,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2010,7 +2394,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 2,
 				},
-				Duration: tracksToSuggest[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[1].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2022,11 +2407,12 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[0].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 		}
 
-		s.Equal(expected, mtvState.Tracks)
+		s.Equal(expected, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkVoteCounted)
 
 	//Test that a finished track can be suggest again and voted again
@@ -2072,7 +2458,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 2,
 				},
-				Duration: tracksToSuggest[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[1].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2084,7 +2471,8 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[0].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2096,12 +2484,13 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 					},
 					Score: 1,
 				},
-				Duration: tracks[0].Duration.Milliseconds(),
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
 		s.Equal(expectedCurrentTrackID, mtvState.CurrentTrack.ID)
-		s.Equal(expected, mtvState.Tracks)
+		s.Equal(expected, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkThatCreatorCouldSuggestAndVoteForPreviousCurrentTrack)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
@@ -2152,7 +2541,7 @@ func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -2240,7 +2629,8 @@ func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed
 					},
 					Score: 1,
 				},
-				Duration: tracks[0].Duration.Milliseconds(),
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2252,7 +2642,8 @@ func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed
 					},
 					Score: 1,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
@@ -2260,7 +2651,7 @@ func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed
 		s.Equal(2, mtvState.UsersLength)
 		s.True(mtvState.Playing)
 		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkJoinAndVoteWorkedAlsoRoomIsPlaying)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
@@ -2311,7 +2702,7 @@ func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayA
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -2380,7 +2771,8 @@ func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayA
 					},
 					Score: 2,
 				},
-				Duration: tracks[2].Duration.Milliseconds(),
+				Duration:      tracks[2].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2392,7 +2784,8 @@ func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayA
 					},
 					Score: 1,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
@@ -2400,7 +2793,7 @@ func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayA
 		s.Equal(2, mtvState.UsersLength)
 		s.False(mtvState.Playing)
 		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkJoinAndVoteWorkedAlsoRoomIsNotPlaying)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
@@ -2439,7 +2832,7 @@ func (s *UnitTestSuite) Test_LoadedAndEndedCurrentTrackAndNoTrackReadyToBePlayed
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -2564,12 +2957,13 @@ func (s *UnitTestSuite) Test_LoadedAndEndedCurrentTrackAndNoTrackReadyToBePlayed
 					},
 					Score: 1,
 				},
-				Duration: trackToSuggest.Duration.Milliseconds(),
+				Duration:      trackToSuggest.Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
 		s.False(mtvState.Playing)
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkSuggestWorked)
 
 	joiningUserVoteForSuggestedTrack := defaultDuration
@@ -2674,7 +3068,7 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestPosit
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -2709,7 +3103,8 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestPosit
 					},
 					Score: 0,
 				},
-				Duration: tracks[0].Duration.Milliseconds(),
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2721,14 +3116,15 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestPosit
 					},
 					Score: 0,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
 		s.False(mtvState.Playing)
 		s.Equal(1, mtvState.MinimumScoreToBePlayed)
 		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks) //here
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks)) //here
 		s.True(mtvState.RoomHasTimeAndPositionConstraints)
 	}, init)
 
@@ -2838,7 +3234,7 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeC
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -2868,7 +3264,8 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeC
 					},
 					Score: 0,
 				},
-				Duration: tracks[0].Duration.Milliseconds(),
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2880,14 +3277,15 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeC
 					},
 					Score: 0,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
 		s.False(mtvState.Playing)
 		s.Equal(1, mtvState.MinimumScoreToBePlayed)
 		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, init)
 
 	updateCreatorAbilityToVoteForTime := defaultDuration
@@ -2945,7 +3343,8 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeC
 					},
 					Score: 0,
 				},
-				Duration: tracks[0].Duration.Milliseconds(),
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -2957,13 +3356,14 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeC
 					},
 					Score: 0,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
 		s.False(mtvState.Playing)
 		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 	}, checkVoteDidntWorked)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
@@ -3003,7 +3403,7 @@ func (s *UnitTestSuite) Test_CreateDirectRoomAndUpdateDelegationOwner() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3193,7 +3593,7 @@ func (s *UnitTestSuite) Test_CreateBroadcastRoomAndAttemptToExecuteDelegationOpe
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3295,7 +3695,7 @@ func (s *UnitTestSuite) Test_CanUpdateControlAndDelegationPermission() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3394,7 +3794,7 @@ func (s *UnitTestSuite) Test_GetUsersListQuery() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3411,6 +3811,7 @@ func (s *UnitTestSuite) Test_GetUsersListQuery() {
 				HasControlAndDelegationPermission: true,
 				IsCreator:                         true,
 				IsDelegationOwner:                 false,
+				IsOwner:                           true,
 			},
 		}
 
@@ -3437,12 +3838,14 @@ func (s *UnitTestSuite) Test_GetUsersListQuery() {
 				HasControlAndDelegationPermission: true,
 				IsCreator:                         true,
 				IsDelegationOwner:                 false,
+				IsOwner:                           true,
 			},
 			{
 				UserID:                            joiningUserID,
 				HasControlAndDelegationPermission: false,
 				IsCreator:                         false,
 				IsDelegationOwner:                 false,
+				IsOwner:                           false,
 			},
 		}
 
@@ -3486,7 +3889,7 @@ func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3508,6 +3911,7 @@ func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
 				HasControlAndDelegationPermission: true,
 				IsCreator:                         true,
 				IsDelegationOwner:                 true,
+				IsOwner:                           true,
 			},
 		}
 
@@ -3542,12 +3946,14 @@ func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
 				HasControlAndDelegationPermission: true,
 				IsCreator:                         true,
 				IsDelegationOwner:                 false,
+				IsOwner:                           true,
 			},
 			{
 				UserID:                            joiningUserID,
 				HasControlAndDelegationPermission: false,
 				IsCreator:                         false,
 				IsDelegationOwner:                 true,
+				IsOwner:                           false,
 			},
 		}
 
@@ -3569,6 +3975,7 @@ func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
 				HasControlAndDelegationPermission: true,
 				IsCreator:                         true,
 				IsDelegationOwner:                 true,
+				IsOwner:                           true,
 			},
 		}
 
@@ -3612,7 +4019,7 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPlay() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3736,7 +4143,7 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPause() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3886,7 +4293,7 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -3958,12 +4365,13 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack(
 					},
 					Score: 1,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
 
 		s.False(mtvState.Playing)
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
 	}, checkGoToNextTrackFailed)
 
@@ -4055,7 +4463,7 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -4139,10 +4547,11 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 					},
 					Score: 1,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 
 		expectedJoiningUser := &shared_mtv.InternalStateUser{
 			UserID:                            joiningUserID,
@@ -4180,10 +4589,11 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 					},
 					Score: 2,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 
 		expectedInvitedUser := &shared_mtv.InternalStateUser{
 			UserID:                            invitedUserID,
@@ -4227,7 +4637,8 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 					},
 					Score: 2,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -4239,10 +4650,11 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 					},
 					Score: 0,
 				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[0].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
 
 		expectedJoiningUser := &shared_mtv.InternalStateUser{
 			UserID:                            joiningUserID,
@@ -4286,7 +4698,8 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 					},
 					Score: 2,
 				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				Duration:      tracks[1].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -4298,7 +4711,8 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 					},
 					Score: 1,
 				},
-				Duration: tracksToSuggest[1].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[1].Duration.Milliseconds(),
+				AddedByUserID: invitedUserID,
 			},
 			{
 				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
@@ -4310,21 +4724,2270 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 					},
 					Score: 0,
 				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				Duration:      tracksToSuggest[0].Duration.Milliseconds(),
+				AddedByUserID: joiningUserID,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
+
+		expectedInvitedUser := &shared_mtv.InternalStateUser{
+			UserID:                            invitedUserID,
+			DeviceID:                          invitedUserDeviceID,
+			TracksVotedFor:                    []string{tracks[1].ID, tracksToSuggest[1].ID},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+			UserHasBeenInvited:                true,
+		}
+		s.Equal(expectedInvitedUser, mtvState.UserRelatedInformation)
+	}, checkInvitedUserSuggestDidMakeHimVoteForSuggestedTrack)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_SuggestOrVoteSentActivityBaseOnCurrentTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID       = faker.UUIDHyphenated()
+		joiningUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksIDs := []string{tracks[0].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
+	defaultDuration := 1 * time.Millisecond
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{tracksIDs[0]},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+			UserHasBeenInvited:                false,
+		}
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
+			},
+		}
+
+		var expectedExposedCurrentTrack *shared_mtv.ExposedCurrentTrack = nil
+
+		s.False(mtvState.Playing)
+		s.Nil(mtvState.TimeConstraintIsValid)
+		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
+	}, init)
+
+	//Emit join for joiningUser
+	emitJoinSignalForJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		}
+		s.emitJoinSignal(args)
+	}, emitJoinSignalForJoiningUser)
+
+	//Emit vote for track with joiningUser
+	emitVoteForJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: tracksIDs[0],
+			UserID:  joiningUserID,
+		}
+		s.emitVoteSignal(args)
+	}, emitVoteForJoiningUser)
+
+	waitForVoteOrSuggestInterval := shared_mtv.CheckForVoteUpdateIntervalDuration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+
+		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
+			CurrentTrack: shared_mtv.CurrentTrack{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						ArtistName: tracks[0].ArtistName,
+						Title:      tracks[0].Title,
+						Duration:   0,
+					},
+
+					Score: 2,
+				},
+
+				AlreadyElapsed: 0,
+			},
+			Duration: tracks[0].Duration.Milliseconds(),
+			Elapsed:  waitForVoteOrSuggestInterval.Milliseconds(),
+		}
+
+		s.True(mtvState.Playing)
+		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, waitForVoteOrSuggestInterval)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now()
+
+	end := start.Add(defaultDuration * 5000)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.AcknowledgeUpdateTimeConstraint,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.True(*mtvState.TimeConstraintIsValid)
+	}, init)
+
+	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+	}, checkTimeConstraintBecomesFalsy)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartAfterNow() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.AcknowledgeUpdateTimeConstraint,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Twice()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+	}, init)
+
+	checkTimeConstraintBecomesTruthy := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.True(*mtvState.TimeConstraintIsValid)
+	}, checkTimeConstraintBecomesTruthy)
+
+	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+	}, checkTimeConstraintBecomesFalsy)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsNilButHasPhysicalAndTimeConstraintsTrue() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = nil
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("PhysicalAndTimeConstraints nil but HasPhysicalAndTimeConstraints true", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsSetButHasPhysicalAndTimeConstraintsFalse() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = false
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("PhysicalAndTimeConstraints set but HasPhysicalAndTimeConstraints false", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartIsAfterEnd() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   start,
+		PhysicalConstraintStartsAt: end,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("start is after end", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartEqualEnd() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: end,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("start equal end", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndIsBeforeNow() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	now := time.Now()
+	start := now.Add(time.Duration(-2) * time.Minute)
+
+	end := now.Add(time.Duration(-1) * time.Minute)
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("end is before now", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndEqualNow() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+
+	now := TimeWrapper()
+	start := now.Add(time.Duration(-2) * time.Minute)
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   now,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("end equal now", applicationErr.Error())
+
+}
+
+func (s *UnitTestSuite) Test_MtvRoomFailPlayingModeIsInvalid() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+	params.PlayingMode = "UNKNOWN_PLAYING_MODE"
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("PlayingMode is invalid", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomFailIsOpenOnlyInvitedUsersCanVoteTrueButIsOpenFalse() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+	params.IsOpen = false
+	params.IsOpenOnlyInvitedUsersCanVote = true
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("IsOpenOnlyInvitedUsersCanVote true but IsOpen false", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracksListUpdate() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	tracksIDsToSuggest := []string{faker.UUIDHyphenated()}
+	tracksToSuggestMetadata := []shared.TrackMetadata{
+		{
+			ID:         tracksIDsToSuggest[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	start := time.Now()
+
+	end := start.Add(defaultDuration * 5000)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
+	creatorUserID := params.RoomCreatorUserID
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		tracksIDsToSuggest,
+		creatorUserID,
+		creatorDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: tracksToSuggestMetadata,
+		UserID:   creatorUserID,
+		DeviceID: creatorDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	// This activity must be called when a user outside of the
+	// room area suggests a song.
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        &shared_mtv.FalseValue,
+			HasControlAndDelegationPermission: true,
+		}
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
+			},
+		}
+
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.False(mtvState.Playing)
+		s.Equal(1, mtvState.MinimumScoreToBePlayed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
+		s.True(mtvState.RoomHasTimeAndPositionConstraints)
+	}, init)
+
+	creatorSuggestsATrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: tracksIDsToSuggest,
+			UserID:          creatorUserID,
+			DeviceID:        creatorDeviceID,
+		})
+	}, creatorSuggestsATrack)
+
+	checkSongHasBeenSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration:      tracks[0].Duration.Milliseconds(),
+				AddedByUserID: params.RoomCreatorUserID,
+			},
+
+			// Song suggested by the creator
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracksToSuggestMetadata[0].ID,
+						Title:      tracksToSuggestMetadata[0].Title,
+						ArtistName: tracksToSuggestMetadata[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration:      tracksToSuggestMetadata[0].Duration.Milliseconds(),
+				AddedByUserID: creatorUserID,
+			},
+		}
+
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Equal(expectedTracks, s.tracksWithoutAddedAt(mtvState.Tracks))
+	}, checkSongHasBeenSuggested)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	start := time.Now()
+
+	end := start.Add(defaultDuration * 5000)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedDetails := shared_mtv.MtvRoomConstraintsDetails{
+			PhysicalConstraintEndsAt:   end.Format(time.RFC3339),
+			PhysicalConstraintStartsAt: start.Format(time.RFC3339),
+			PhysicalConstraintRadius:   5000,
+			RoomID:                     params.RoomID,
+			PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+				Lat: 42,
+				Lng: 42,
+			},
+		}
+
+		details := s.getMtvRoomConstraintsDetails()
+		s.Equal(expectedDetails, details)
+	}, init)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConstraints() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		_, err := s.env.QueryWorkflow(shared_mtv.MtvGetRoomConstraintsDetails)
+
+		s.Error(err)
+		s.ErrorIs(err, ErrRoomDoesNotHaveConstraints)
+	}, init)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomPanicAfterUnkownWorkflowSignal() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 200 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUnkownSignal()
+	}, init)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var panicError *temporal.PanicError
+	s.True(errors.As(err, &panicError))
+	s.Contains(panicError.Error(), ErrUnknownWorflowSignal.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomExitsAfterTerminateSignal() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	initialTracksIDs := []string{tracks[0].ID}
+
+	params, _ := getWorkflowInitParams(initialTracksIDs, 1)
+	defaultDuration := 200 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		initialTracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitTerminateWorkflowSignal()
+	}, init)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Nil(err)
+}
+
+func (s *UnitTestSuite) Test_VoteToSkipCurrentTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		firstUserID     = faker.UUIDHyphenated()
+		secondUserID    = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.VoteToSkipThreshold = &shared_mtv.MtvRoomVoteToSkipThreshold{
+		Kind:  shared_mtv.MtvVoteToSkipThresholdKindPercentage,
+		Value: 50,
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeVoteToSkipCurrentTrack,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+
+	// 1. Two users join the room, with the creator it makes three users.
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   firstUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   secondUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+	}, usersJoin)
+
+	// 2. With 50% of three users, two skip votes are required.
+	checkRequiredSkipVotesCount := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(3, mtvState.UsersLength)
+		s.Equal(0, mtvState.SkipVotesCount)
+		s.NotNil(mtvState.RequiredSkipVotesCount)
+		s.Equal(2, *mtvState.RequiredSkipVotesCount)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkRequiredSkipVotesCount)
+
+	// 3. The creator votes to skip the current track twice,
+	// only the first vote is taken into account.
+	creatorVotesToSkip := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, creatorVotesToSkip)
+
+	checkCreatorSkipVoteHasBeenCounted := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.False(mtvState.Playing)
+		s.Equal(1, mtvState.SkipVotesCount)
+		s.True(mtvState.UserRelatedInformation.HasVotedToSkipCurrentTrack)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkCreatorSkipVoteHasBeenCounted)
+
+	// 4. A user that is not part of the room can not vote to skip.
+	unknownUserVotesToSkip := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: faker.UUIDHyphenated(),
+		})
+	}, unknownUserVotesToSkip)
+
+	checkUnknownUserSkipVoteHasBeenIgnored := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(1, mtvState.SkipVotesCount)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkUnknownUserSkipVoteHasBeenIgnored)
+
+	// 5. The second skip vote reaches the threshold, the room goes to the next track.
+	firstUserVotesToSkip := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: firstUserID,
+		})
+	}, firstUserVotesToSkip)
+
+	checkCurrentTrackHasBeenSkipped := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Equal(0, mtvState.SkipVotesCount)
+		s.False(mtvState.UserRelatedInformation.HasVotedToSkipCurrentTrack)
+	}, checkCurrentTrackHasBeenSkipped)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_VoteToSkipIsAppliedOnceNextTrackIsQueued() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration   = 1 * time.Millisecond
+		firstUserID       = faker.UUIDHyphenated()
+		firstUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	suggestedTrack := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.VoteToSkipThreshold = &shared_mtv.MtvRoomVoteToSkipThreshold{
+		Kind:  shared_mtv.MtvVoteToSkipThresholdKindPercentage,
+		Value: 50,
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		[]string{suggestedTrack.ID},
+		firstUserID,
+		firstUserDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: []shared.TrackMetadata{suggestedTrack},
+		UserID:   firstUserID,
+		DeviceID: firstUserDeviceID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeVoteToSkipCurrentTrack,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestion,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   firstUserID,
+			DeviceID: firstUserDeviceID,
+		})
+	}, usersJoin)
+
+	// Both users vote to skip while there is no next track to play.
+	usersVoteToSkip := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: firstUserID,
+		})
+	}, usersVoteToSkip)
+
+	checkCurrentTrackHasNotBeenSkipped := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(2, mtvState.SkipVotesCount)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkCurrentTrackHasNotBeenSkipped)
+
+	suggestTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{suggestedTrack.ID},
+			UserID:          firstUserID,
+			DeviceID:        firstUserDeviceID,
+		})
+	}, suggestTrack)
+
+	checkCurrentTrackHasBeenSkipped := shared_mtv.CheckForVoteUpdateIntervalDuration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(suggestedTrack.ID, mtvState.CurrentTrack.ID)
+		s.Equal(0, mtvState.SkipVotesCount)
+	}, checkCurrentTrackHasBeenSkipped)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_VoteToSkipIsAppliedWhenUsersLeaveTheRoom() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		firstUserID     = faker.UUIDHyphenated()
+		secondUserID    = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.VoteToSkipThreshold = &shared_mtv.MtvRoomVoteToSkipThreshold{
+		Kind:  shared_mtv.MtvVoteToSkipThresholdKindPercentage,
+		Value: 100,
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.LeaveActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+	s.env.OnActivity(
+		a.AcknowledgeVoteToSkipCurrentTrack,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   firstUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   secondUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+	}, usersJoin)
+
+	// Two of the three users vote to skip, all of them are required.
+	usersVoteToSkip := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: firstUserID,
+		})
+	}, usersVoteToSkip)
+
+	checkCurrentTrackHasNotBeenSkipped := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(2, mtvState.SkipVotesCount)
+		s.Equal(3, *mtvState.RequiredSkipVotesCount)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkCurrentTrackHasNotBeenSkipped)
+
+	// The user who did not vote leaves, the two votes are now enough.
+	secondUserLeaves := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitLeaveSignal(secondUserID)
+	}, secondUserLeaves)
+
+	checkCurrentTrackHasBeenSkipped := shared_mtv.CheckForVoteUpdateIntervalDuration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Equal(0, mtvState.SkipVotesCount)
+	}, checkCurrentTrackHasBeenSkipped)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomFailVoteToSkipThresholdPercentageIsGreaterThanHundred() {
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.VoteToSkipThreshold = &shared_mtv.MtvRoomVoteToSkipThreshold{
+		Kind:  shared_mtv.MtvVoteToSkipThresholdKindPercentage,
+		Value: 101,
+	}
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("VoteToSkipThreshold percentage is greater than 100", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_DownvoteTrackAndEvictItBelowScoreFloor() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		firstUserID     = faker.UUIDHyphenated()
+		secondUserID    = faker.UUIDHyphenated()
+		evictionFloor   = 1
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.EvictionScoreFloor = &evictionFloor
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+	s.env.OnActivity(
+		a.UserVoteForTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserDownvoteTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.NotifyTrackEvictedActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	// 1. Two users join the room, the queue contains the second initial track
+	// which has been voted by the creator.
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   firstUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   secondUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+	}, usersJoin)
+
+	// 2. The first user votes for the track and then tries to downvote it.
+	firstUserVotesAndDownvotes := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  firstUserID,
+			TrackID: tracks[1].ID,
+		})
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteTrackSignalArgs{
+			UserID:  firstUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, firstUserVotesAndDownvotes)
+
+	// 3. The downvote must have been rejected as the user already voted for the track.
+	checkDownvoteHasBeenRejected := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(firstUserID)
+
+		s.Equal(2, mtvState.Tracks[0].Score)
+		s.Empty(mtvState.UserRelatedInformation.TracksDownvotedFor)
+		s.NotNil(mtvState.EvictionScoreFloor)
+		s.Equal(evictionFloor, *mtvState.EvictionScoreFloor)
+	}, checkDownvoteHasBeenRejected)
+
+	// 4. The second user downvotes the track twice, only the first downvote counts.
+	secondUserDownvotesTwice := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteTrackSignalArgs{
+			UserID:  secondUserID,
+			TrackID: tracks[1].ID,
+		})
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteTrackSignalArgs{
+			UserID:  secondUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, secondUserDownvotesTwice)
+
+	checkSecondUserDownvote := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(secondUserID)
+
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(1, mtvState.Tracks[0].Score)
+		s.Equal([]string{tracks[1].ID}, mtvState.UserRelatedInformation.TracksDownvotedFor)
+	}, checkSecondUserDownvote)
+
+	// 5. The second user can not vote for a track it downvoted.
+	secondUserVotesForDownvotedTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  secondUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, secondUserVotesForDownvotedTrack)
+
+	checkVoteHasBeenRejected := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(secondUserID)
+
+		s.Equal(1, mtvState.Tracks[0].Score)
+		s.Empty(mtvState.UserRelatedInformation.TracksVotedFor)
+	}, checkVoteHasBeenRejected)
+
+	// 6. The creator can not downvote a track it voted for,
+	// a downvote from a third user makes the score drop below the floor.
+	thirdUserID := faker.UUIDHyphenated()
+	thirdUserJoinsAndDownvotes := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteTrackSignalArgs{
+			UserID:  params.RoomCreatorUserID,
+			TrackID: tracks[1].ID,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   thirdUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteTrackSignalArgs{
+			UserID:  thirdUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, thirdUserJoinsAndDownvotes)
+
+	// 7. The track has been evicted from the queue and votes have been cleared.
+	checkTrackHasBeenEvicted := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(secondUserID)
+
+		s.Empty(mtvState.Tracks)
+		s.Empty(mtvState.UserRelatedInformation.TracksDownvotedFor)
+
+		creatorState := s.getMtvState(params.RoomCreatorUserID)
+		s.Empty(creatorState.UserRelatedInformation.TracksVotedFor)
+	}, checkTrackHasBeenEvicted)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_UnvoteForTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		joiningUserID   = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserVoteForTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserUnvoteForTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	// Vote and unvote happen in the same vote update interval,
+	// adonis must receive only one batched update.
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	// 1. A user joins and votes for the last track of the queue,
+	// which goes to the top of the queue.
+	userJoinsAndVotes := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   joiningUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  joiningUserID,
+			TrackID: tracks[2].ID,
+		})
+	}, userJoinsAndVotes)
+
+	checkVoteHasBeenCounted := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.Equal(tracks[2].ID, mtvState.Tracks[0].ID)
+		s.Equal(2, mtvState.Tracks[0].Score)
+		s.Equal([]string{tracks[2].ID}, mtvState.UserRelatedInformation.TracksVotedFor)
+	}, checkVoteHasBeenCounted)
+
+	// 2. The user withdraws its vote, then tries to withdraw a vote
+	// it never gave.
+	userUnvotes := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUnvoteSignal(shared_mtv.NewUnvoteForTrackSignalArgs{
+			UserID:  joiningUserID,
+			TrackID: tracks[2].ID,
+		})
+		s.emitUnvoteSignal(shared_mtv.NewUnvoteForTrackSignalArgs{
+			UserID:  joiningUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, userUnvotes)
+
+	// 3. The score has been decremented.
+	checkUnvoteHasBeenCounted := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.Len(mtvState.Tracks, 2)
+		s.Equal(tracks[2].ID, mtvState.Tracks[0].ID)
+		s.Equal(1, mtvState.Tracks[0].Score)
+		s.Equal(tracks[1].ID, mtvState.Tracks[1].ID)
+		s.Equal(1, mtvState.Tracks[1].Score)
+		s.Empty(mtvState.UserRelatedInformation.TracksVotedFor)
+	}, checkUnvoteHasBeenCounted)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_GetHistoryQuery() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+
+	// 1. The initial current track has not been played yet,
+	// the history is empty.
+	checkHistoryIsEmpty := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		history := s.getHistory(1, 10)
+
+		s.Empty(history.Tracks)
+		s.Equal(1, history.Page)
+		s.False(history.HasMore)
+	}, checkHistoryIsEmpty)
+
+	emitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
+
+	// 2. The first track is skipped after having been listened 10ms.
+	goToNextTrack := defaultDuration * 10
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, goToNextTrack)
+
+	checkSkippedTrackIsInHistory := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		history := s.getHistory(1, 10)
+
+		s.Len(history.Tracks, 1)
+		s.False(history.HasMore)
+
+		playedTrack := history.Tracks[0]
+		s.Equal(tracks[0].ID, playedTrack.ID)
+		s.Equal(1, playedTrack.Score)
+		s.Equal(tracks[0].Duration.Milliseconds(), playedTrack.Duration)
+		s.Equal(params.RoomCreatorUserID, playedTrack.AddedByUserID)
+		s.Equal((defaultDuration * 10).Milliseconds(), playedTrack.Listened)
+		s.NotEmpty(playedTrack.StartedAt)
+	}, checkSkippedTrackIsInHistory)
+
+	// 3. The second track is listened until its end,
+	// the most recently played track comes first.
+	waitForSecondTrackEnd := tracks[1].Duration
+	registerDelayedCallbackWrapper(func() {
+		firstPage := s.getHistory(1, 1)
+
+		s.Len(firstPage.Tracks, 1)
+		s.True(firstPage.HasMore)
+		s.Equal(tracks[1].ID, firstPage.Tracks[0].ID)
+		s.Equal(tracks[1].Duration.Milliseconds(), firstPage.Tracks[0].Listened)
+
+		secondPage := s.getHistory(2, 1)
+
+		s.Len(secondPage.Tracks, 1)
+		s.Equal(2, secondPage.Page)
+		s.False(secondPage.HasMore)
+		s.Equal(tracks[0].ID, secondPage.Tracks[0].ID)
+
+		s.Empty(s.getHistory(3, 1).Tracks)
+	}, waitForSecondTrackEnd)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_SuggestionCooldownRejectsRecentlyPlayedTracks() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
+	suggestedTrack := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	params.SuggestionCooldown = &shared_mtv.MtvRoomSuggestionCooldown{
+		Kind:  shared_mtv.MtvSuggestionCooldownKindTracksCount,
+		Value: 1,
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+	//The rejected track is reported in the acknowledgement of the accepted one
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestionFail,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		[]string{suggestedTrack.ID},
+		params.RoomCreatorUserID,
+		creatorDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: []shared.TrackMetadata{suggestedTrack},
+		UserID:   params.RoomCreatorUserID,
+		DeviceID: creatorDeviceID,
+	}, nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		mock.Anything,
+		[]string{tracks[0].ID},
+		params.RoomCreatorUserID,
+		creatorDeviceID,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: []shared.TrackMetadata{tracks[0]},
+		UserID:   params.RoomCreatorUserID,
+		DeviceID: creatorDeviceID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestion,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeTracksSuggestionArgs) bool {
+			expectedRejectedTracks := []shared_mtv.RejectedTrack{
+				{
+					TrackID: tracks[0].ID,
+					Reason:  shared_mtv.TrackRejectionReasonRecentlyPlayed,
+				},
+			}
+
+			return args.DeviceID == creatorDeviceID && reflect.DeepEqual(expectedRejectedTracks, args.RejectedTracks)
+		}),
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestion,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeTracksSuggestionArgs) bool {
+			return args.DeviceID == creatorDeviceID && len(args.RejectedTracks) == 0
+		}),
+	).Return(nil).Once()
+
+	// 1. The first track is played then skipped.
+	emitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
+
+	firstGoToNextTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, firstGoToNextTrack)
+
+	// 2. Suggesting it again is rejected, the other suggested track is accepted.
+	suggestRecentlyPlayedTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{tracks[0].ID, suggestedTrack.ID},
+			UserID:          params.RoomCreatorUserID,
+			DeviceID:        creatorDeviceID,
+		})
+	}, suggestRecentlyPlayedTrack)
+
+	checkOnlyOneTrackHasBeenSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Len(mtvState.Tracks, 2)
+		s.Equal(tracks[2].ID, mtvState.Tracks[0].ID)
+		s.Equal(suggestedTrack.ID, mtvState.Tracks[1].ID)
+	}, checkOnlyOneTrackHasBeenSuggested)
+
+	// 3. Once another track has been played, the cooldown is over.
+	secondGoToNextTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, secondGoToNextTrack)
+
+	suggestTrackAfterCooldown := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{tracks[0].ID},
+			UserID:          params.RoomCreatorUserID,
+			DeviceID:        creatorDeviceID,
+		})
+	}, suggestTrackAfterCooldown)
+
+	checkTrackHasBeenSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Equal(tracks[2].ID, mtvState.CurrentTrack.ID)
+		s.Len(mtvState.Tracks, 2)
+		s.Equal(suggestedTrack.ID, mtvState.Tracks[0].ID)
+		s.Equal(tracks[0].ID, mtvState.Tracks[1].ID)
+	}, checkTrackHasBeenSuggested)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomFailSuggestionCooldownTracksCountIsGreaterThanHistoryMaxLength() {
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.SuggestionCooldown = &shared_mtv.MtvRoomSuggestionCooldown{
+		Kind:  shared_mtv.MtvSuggestionCooldownKindTracksCount,
+		Value: shared_mtv.PlayedTracksHistoryMaxLength + 1,
+	}
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("SuggestionCooldown tracks count is greater than played tracks history max length", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_AutofillFromSeedTracksWhenQueueRunsDry() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	seedTracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.Autofill = &shared_mtv.MtvRoomAutofill{
+		Source:        shared_mtv.MtvAutofillSourceSeedTracks,
+		SeedTracksIDs: []string{seedTracks[0].ID, seedTracks[1].ID},
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		[]string{seedTracks[0].ID},
+	).Return(activities.FetchedTracksInformation{Metadata: []shared.TrackMetadata{seedTracks[0]}}, nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		[]string{seedTracks[1].ID},
+	).Return(activities.FetchedTracksInformation{Metadata: []shared.TrackMetadata{seedTracks[1]}}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+
+	emitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
+
+	// 1. The only track of the queue ends, the first seed track is played.
+	waitForInitialTrackEnd := tracks[0].Duration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(seedTracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Empty(mtvState.Tracks)
+	}, waitForInitialTrackEnd)
+
+	// 2. Seed tracks are picked in turn.
+	waitForFirstSeedTrackEnd := seedTracks[0].Duration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(seedTracks[1].ID, mtvState.CurrentTrack.ID)
+	}, waitForFirstSeedTrackEnd)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_AutofillPicksTheEndedTrackAsLastResort() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.Autofill = &shared_mtv.MtvRoomAutofill{
+		Source:        shared_mtv.MtvAutofillSourceSeedTracks,
+		SeedTracksIDs: tracksIDs,
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Times(2)
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
 
-		expectedInvitedUser := &shared_mtv.InternalStateUser{
-			UserID:                            invitedUserID,
-			DeviceID:                          invitedUserDeviceID,
-			TracksVotedFor:                    []string{tracks[1].ID, tracksToSuggest[1].ID},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
-			UserHasBeenInvited:                true,
-		}
-		s.Equal(expectedInvitedUser, mtvState.UserRelatedInformation)
-	}, checkInvitedUserSuggestDidMakeHimVoteForSuggestedTrack)
+	emitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
+
+	// The only seed track is the one that just ended, it is played again.
+	waitForInitialTrackEnd := tracks[0].Duration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Empty(mtvState.Tracks)
+	}, waitForInitialTrackEnd)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -4333,12 +6996,11 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_SuggestOrVoteSentActivityBaseOnCurrentTrack() {
+func (s *UnitTestSuite) Test_AutofillTriesNextCandidateWhenFetchingFails() {
 	var (
 		a *activities_mtv.Activities
 
-		joiningUserID       = faker.UUIDHyphenated()
-		joiningUserDeviceID = faker.UUIDHyphenated()
+		defaultDuration = 1 * time.Millisecond
 	)
 
 	tracks := []shared.TrackMetadata{
@@ -4349,128 +7011,221 @@ func (s *UnitTestSuite) Test_SuggestOrVoteSentActivityBaseOnCurrentTrack() {
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-
+	seedTracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
 	tracksIDs := []string{tracks[0].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
-	defaultDuration := 1 * time.Millisecond
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.Autofill = &shared_mtv.MtvRoomAutofill{
+		Source:        shared_mtv.MtvAutofillSourceSeedTracks,
+		SeedTracksIDs: []string{seedTracks[0].ID, seedTracks[1].ID},
+	}
 
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		a.NotifySuggestOrVoteUpdateActivity,
+		activities.FetchTracksInformationActivity,
 		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
 		mock.Anything,
-	).Return(nil).Once()
+		[]string{seedTracks[0].ID},
+	).Return(activities.FetchedTracksInformation{Metadata: []shared.TrackMetadata{}}, nil).Once()
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		[]string{seedTracks[1].ID},
+	).Return(activities.FetchedTracksInformation{Metadata: []shared.TrackMetadata{seedTracks[1]}}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
 
-	init := defaultDuration
+	emitPlaySignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{tracksIDs[0]},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: true,
-			UserHasBeenInvited:                false,
-		}
+	// The first seed track can not be fetched, the second one is played.
+	waitForInitialTrackEnd := tracks[0].Duration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-		}
+		s.True(mtvState.Playing)
+		s.Equal(seedTracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Empty(mtvState.Tracks)
+	}, waitForInitialTrackEnd)
 
-		var expectedExposedCurrentTrack *shared_mtv.ExposedCurrentTrack = nil
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
-		s.False(mtvState.Playing)
-		s.Nil(mtvState.TimeConstraintIsValid)
-		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, init)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
 
-	//Emit join for joiningUser
-	emitJoinSignalForJoiningUser := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
-			UserID:             joiningUserID,
-			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignalForJoiningUser)
+func (s *UnitTestSuite) Test_MtvRoomFailAutofillSeedTracksAreMissing() {
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.Autofill = &shared_mtv.MtvRoomAutofill{
+		Source: shared_mtv.MtvAutofillSourceSeedTracks,
+	}
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
 
-	//Emit vote for track with joiningUser
-	emitVoteForJoiningUser := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: tracksIDs[0],
-			UserID:  joiningUserID,
-		}
-		s.emitVoteSignal(args)
-	}, emitVoteForJoiningUser)
+	defer resetMock()
 
-	waitForVoteOrSuggestInterval := shared_mtv.CheckForVoteUpdateIntervalDuration + defaultDuration
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("Autofill seed tracks are missing", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_ShuffleQueueModePicksNextTrackAmongReadyTracks() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID, tracks[3].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.QueueMode = shared_mtv.MtvQueueModeShuffle
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	oldRandomIntnImplem := RandomIntnWrapper
+	randomIntnMock := new(mocks.RandomIntnWrapperType)
+	// The initial track is picked among the four fetched tracks
+	randomIntnMock.On("Execute", 4).Return(0).Once()
+	// Three tracks are then ready to be played, the last one is picked
+	randomIntnMock.On("Execute", 3).Return(2).Once()
+	// The remaining tracks are then played in their queue order
+	randomIntnMock.On("Execute", 2).Return(0).Maybe()
+	RandomIntnWrapper = randomIntnMock.Execute
+
+	defer func() {
+		RandomIntnWrapper = oldRandomIntnImplem
+	}()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	checkInitialState := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
-			CurrentTrack: shared_mtv.CurrentTrack{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						ArtistName: tracks[0].ArtistName,
-						Title:      tracks[0].Title,
-						Duration:   0,
-					},
+		s.Equal(shared_mtv.MtvQueueModeShuffle, mtvState.QueueMode)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkInitialState)
 
-					Score: 2,
-				},
+	goToNextTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, goToNextTrack)
 
-				AlreadyElapsed: 0,
-			},
-			Duration: tracks[0].Duration.Milliseconds(),
-			Elapsed:  waitForVoteOrSuggestInterval.Milliseconds(),
-		}
+	checkRandomTrackHasBeenPicked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		s.True(mtvState.Playing)
-		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, waitForVoteOrSuggestInterval)
+		s.Equal(tracks[3].ID, mtvState.CurrentTrack.ID)
+		s.Len(mtvState.Tracks, 2)
+		s.Equal(tracks[1].ID, mtvState.Tracks[0].ID)
+		s.Equal(tracks[2].ID, mtvState.Tracks[1].ID)
+	}, checkRandomTrackHasBeenPicked)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
+	randomIntnMock.AssertExpectations(s.T())
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_LoopQueueModeRequeuesPlayedTracks() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4479,64 +7234,80 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
-	defaultDuration := 1 * time.Millisecond
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.QueueMode = shared_mtv.MtvQueueModeLoop
 
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
-	s.env.OnActivity(
-		a.AcknowledgeUpdateTimeConstraint,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Once()
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
 
-	init := defaultDuration
+	emitPlaySignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.True(*mtvState.TimeConstraintIsValid)
-	}, init)
+	// 1. The skipped track goes back at the end of the queue.
+	goToNextTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, goToNextTrack)
 
-	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+	checkSkippedTrackHasBeenRequeued := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.False(*mtvState.TimeConstraintIsValid)
-	}, checkTimeConstraintBecomesFalsy)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(tracks[0].ID, mtvState.Tracks[0].ID)
+		s.Equal(params.MinimumScoreToBePlayed, mtvState.Tracks[0].Score)
+	}, checkSkippedTrackHasBeenRequeued)
+
+	// 2. The track that naturally ended is requeued too, the room never stops.
+	waitForCurrentTrackEnd := tracks[1].Duration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(tracks[1].ID, mtvState.Tracks[0].ID)
+	}, waitForCurrentTrackEnd)
+
+	// The room loops forever, pausing it lets the workflow reach its deadline.
+	pauseRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPauseSignal(shared_mtv.NewPauseSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, pauseRoom)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -4545,8 +7316,13 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartAfterNow() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_UpdateQueueMode() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		joiningUserID   = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4556,72 +7332,102 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartAfterNow() {
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-	defaultDuration := 1 * time.Millisecond
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
-
-	end := start.Add(defaultDuration * 500)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
 
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
-	s.env.OnActivity(
-		a.AcknowledgeUpdateTimeConstraint,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Twice()
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeUpdateQueueMode,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
-	init := defaultDuration
+	emitPlaySignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.False(*mtvState.TimeConstraintIsValid)
-	}, init)
+	// 1. The only track of the room ends, the room is paused.
+	waitForTrackEnd := tracks[0].Duration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-	checkTimeConstraintBecomesTruthy := defaultDuration * 5000
+		s.False(mtvState.Playing)
+		s.Equal(shared_mtv.MtvQueueModeScore, mtvState.QueueMode)
+	}, waitForTrackEnd)
+
+	// 2. A user without control and delegation permission can not update the queue mode.
+	userWithoutPermissionUpdatesQueueMode := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   joiningUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitUpdateQueueModeSignal(shared_mtv.NewUpdateQueueModeSignalArgs{
+			UserID:    joiningUserID,
+			QueueMode: shared_mtv.MtvQueueModeLoop,
+		})
+	}, userWithoutPermissionUpdatesQueueMode)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.True(*mtvState.TimeConstraintIsValid)
-	}, checkTimeConstraintBecomesTruthy)
+	checkQueueModeHasNotBeenUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+		s.False(mtvState.Playing)
+		s.Equal(shared_mtv.MtvQueueModeScore, mtvState.QueueMode)
+	}, checkQueueModeHasNotBeenUpdated)
+
+	// 3. The creator switches to LOOP mode, the ended track is played again.
+	creatorUpdatesQueueMode := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		s.emitUpdateQueueModeSignal(shared_mtv.NewUpdateQueueModeSignalArgs{
+			UserID:    params.RoomCreatorUserID,
+			QueueMode: shared_mtv.MtvQueueModeLoop,
+		})
+	}, creatorUpdatesQueueMode)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.False(*mtvState.TimeConstraintIsValid)
-	}, checkTimeConstraintBecomesFalsy)
+	checkQueueModeHasBeenUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(shared_mtv.MtvQueueModeLoop, mtvState.QueueMode)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Empty(mtvState.Tracks)
+	}, checkQueueModeHasBeenUpdated)
+
+	// The room loops forever, pausing it lets the workflow reach its deadline.
+	pauseRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPauseSignal(shared_mtv.NewPauseSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, pauseRoom)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -4630,7 +7436,13 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartAfterNow() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsNilButHasPhysicalAndTimeConstraintsTrue() {
+func (s *UnitTestSuite) Test_SeekWithinCurrentTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		joiningUserID   = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4639,73 +7451,142 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraints
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = nil
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	seekPosition := tracks[0].Duration / 2
 
-	resetMock, _ := s.initTestEnv()
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
-	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+	//Seeking while playing is acknowledged by the play activity
+	s.env.OnActivity(
+		a.AcknowledgeSeek,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
 
-	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("PhysicalAndTimeConstraints nil but HasPhysicalAndTimeConstraints true", applicationErr.Error())
-}
+	emitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsSetButHasPhysicalAndTimeConstraintsFalse() {
+	// 1. A user without control and delegation permission can not seek.
+	userWithoutPermissionSeeks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   joiningUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitSeekSignal(shared_mtv.NewSeekSignalArgs{
+			UserID:   joiningUserID,
+			Position: seekPosition.Milliseconds(),
+		})
+	}, userWithoutPermissionSeeks)
 
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	defaultDuration := 1 * time.Millisecond
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
+	checkElapsedHasNotBeenUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-	end := start.Add(defaultDuration * 500)
+		s.True(mtvState.Playing)
+		s.Equal(int64(2), mtvState.CurrentTrack.Elapsed)
+	}, checkElapsedHasNotBeenUpdated)
+
+	// 2. Seeking after the end of the current track is ignored.
+	seekAfterCurrentTrackEnd := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSeekSignal(shared_mtv.NewSeekSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			Position: tracks[0].Duration.Milliseconds(),
+		})
+	}, seekAfterCurrentTrackEnd)
+
+	checkElapsedHasStillNotBeenUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal(int64(4), mtvState.CurrentTrack.Elapsed)
+	}, checkElapsedHasStillNotBeenUpdated)
+
+	// 3. The creator seeks in the middle of the current track.
+	creatorSeeks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSeekSignal(shared_mtv.NewSeekSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			Position: seekPosition.Milliseconds(),
+		})
+	}, creatorSeeks)
+
+	checkElapsedHasBeenUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal((seekPosition + defaultDuration).Milliseconds(), mtvState.CurrentTrack.Elapsed)
+	}, checkElapsedHasBeenUpdated)
 
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-	params.HasPhysicalAndTimeConstraints = false
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	// 4. The timer has been restarted for the remaining duration only.
+	waitForCurrentTrackEnd := tracks[0].Duration - seekPosition
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-	resetMock, _ := s.initTestEnv()
+		s.True(mtvState.Playing)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
 
-	defer resetMock()
+		//Only the time actually spent listening to the track is recorded
+		history := s.getHistory(1, 10)
+		s.Len(history.Tracks, 1)
+		listened := time.Duration(history.Tracks[0].Listened) * time.Millisecond
+		s.GreaterOrEqual(listened, tracks[0].Duration-seekPosition)
+		s.Less(listened, tracks[0].Duration-seekPosition+10*defaultDuration)
+	}, waitForCurrentTrackEnd)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("PhysicalAndTimeConstraints set but HasPhysicalAndTimeConstraints false", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartIsAfterEnd() {
+func (s *UnitTestSuite) Test_UpdateSettings() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		joiningUserID   = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4714,86 +7595,147 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartIsAfterEnd() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
-	defaultDuration := 1 * time.Millisecond
-	tracksIDs := []string{tracks[0].ID}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
 	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
-
-	end := start.Add(defaultDuration * 500)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   start,
-		PhysicalConstraintStartsAt: end,
+	initialRoomName := params.RoomName
+	updatedSettings := shared_mtv.MtvRoomSettings{
+		RoomName:                      faker.Sentence(),
+		MinimumScoreToBePlayed:        1,
+		IsOpen:                        true,
+		IsOpenOnlyInvitedUsersCanVote: true,
+		HasPhysicalAndTimeConstraints: false,
+		PhysicalAndTimeConstraints:    nil,
+		PlayingMode:                   shared_mtv.MtvPlayingModeDirect,
 	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
 
-	resetMock, _ := s.initTestEnv()
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
-	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateSettings,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
 
-	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("start is after end", applicationErr.Error())
-}
+	checkInitialState := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartEqualEnd() {
+		s.False(mtvState.Playing)
+		s.Nil(mtvState.CurrentTrack)
+		s.Len(mtvState.Tracks, 2)
+	}, checkInitialState)
 
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	defaultDuration := 1 * time.Millisecond
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
+	// 1. Only the room creator can update the settings.
+	userWhoIsNotCreatorUpdatesSettings := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   joiningUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitUpdateSettingsSignal(shared_mtv.NewUpdateSettingsSignalArgs{
+			UserID:   joiningUserID,
+			Settings: updatedSettings,
+		})
+	}, userWhoIsNotCreatorUpdatesSettings)
 
-	end := start.Add(defaultDuration * 500)
+	checkSettingsHaveNotBeenUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: end,
-	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+		s.Equal(initialRoomName, mtvState.RoomName)
+		s.Equal(2, mtvState.MinimumScoreToBePlayed)
+		s.Nil(mtvState.CurrentTrack)
+	}, checkSettingsHaveNotBeenUpdated)
 
-	resetMock, _ := s.initTestEnv()
+	// 2. Settings are checked with the same rules as at creation.
+	creatorUpdatesInvalidSettings := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		invalidSettings := updatedSettings
+		invalidSettings.IsOpen = false
 
-	defer resetMock()
+		s.emitUpdateSettingsSignal(shared_mtv.NewUpdateSettingsSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			Settings: invalidSettings,
+		})
+	}, creatorUpdatesInvalidSettings)
+
+	checkInvalidSettingsHaveNotBeenApplied := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(initialRoomName, mtvState.RoomName)
+		s.True(mtvState.IsOpen)
+		s.False(mtvState.IsOpenOnlyInvitedUsersCanVotes)
+	}, checkInvalidSettingsHaveNotBeenApplied)
+
+	// 3. Lowering the minimum score lets the first queued track be played.
+	creatorUpdatesSettings := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateSettingsSignal(shared_mtv.NewUpdateSettingsSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			Settings: updatedSettings,
+		})
+	}, creatorUpdatesSettings)
+
+	checkSettingsHaveBeenUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(updatedSettings.RoomName, mtvState.RoomName)
+		s.Equal(1, mtvState.MinimumScoreToBePlayed)
+		s.True(mtvState.IsOpenOnlyInvitedUsersCanVotes)
+		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
+		s.NotNil(mtvState.DelegationOwnerUserID)
+		s.Equal(params.RoomCreatorUserID, *mtvState.DelegationOwnerUserID)
+
+		s.True(mtvState.Playing)
+		s.NotNil(mtvState.CurrentTrack)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Len(mtvState.Tracks, 1)
+	}, checkSettingsHaveBeenUpdated)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("start equal end", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndIsBeforeNow() {
+func (s *UnitTestSuite) Test_UpdateSettingsRearmsTimeConstraintTimers() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4804,83 +7746,124 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndIsBeforeNow() {
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	now := time.Now()
-	start := now.Add(time.Duration(-2) * time.Minute)
-
-	end := now.Add(time.Duration(-1) * time.Minute)
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	start := time.Now().Add(defaultDuration * 5000)
+	end := start.Add(defaultDuration * 5000)
+	settingsWithConstraints := shared_mtv.MtvRoomSettings{
+		RoomName:                      params.RoomName,
+		MinimumScoreToBePlayed:        params.MinimumScoreToBePlayed,
+		IsOpen:                        params.IsOpen,
+		IsOpenOnlyInvitedUsersCanVote: params.IsOpenOnlyInvitedUsersCanVote,
+		HasPhysicalAndTimeConstraints: true,
+		PhysicalAndTimeConstraints: &shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+			PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+				Lat: 42,
+				Lng: 42,
+			},
+			PhysicalConstraintRadius:   5000,
+			PhysicalConstraintStartsAt: start,
+			PhysicalConstraintEndsAt:   end,
 		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
+		PlayingMode: params.PlayingMode,
 	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	settingsWithoutConstraints := settingsWithConstraints
+	settingsWithoutConstraints.HasPhysicalAndTimeConstraints = false
+	settingsWithoutConstraints.PhysicalAndTimeConstraints = nil
 
-	resetMock, _ := s.initTestEnv()
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
-	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateSettings,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	// Ends at timer is canceled when the constraints are removed
+	s.env.OnActivity(
+		a.AcknowledgeUpdateTimeConstraint,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
-	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("end is before now", applicationErr.Error())
-}
+	// 1. The creator adds constraints starting in the future.
+	creatorAddsConstraints := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateSettingsSignal(shared_mtv.NewUpdateSettingsSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			Settings: settingsWithConstraints,
+		})
+	}, creatorAddsConstraints)
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndEqualNow() {
+	checkConstraintsHaveBeenAdded := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	//mocking now
-	resetMock, _ := s.initTestEnv()
-	///
+		s.True(mtvState.RoomHasTimeAndPositionConstraints)
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+		s.NotNil(mtvState.UserRelatedInformation.UserFitsPositionConstraint)
+		s.False(*mtvState.UserRelatedInformation.UserFitsPositionConstraint)
+	}, checkConstraintsHaveBeenAdded)
 
-	now := TimeWrapper()
-	start := now.Add(time.Duration(-2) * time.Minute)
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   now,
-		PhysicalConstraintStartsAt: start,
-	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	// 2. The starts at timer has been armed.
+	waitForConstraintStart := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.True(*mtvState.TimeConstraintIsValid)
+	}, waitForConstraintStart)
+
+	// 3. The creator removes the constraints.
+	creatorRemovesConstraints := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateSettingsSignal(shared_mtv.NewUpdateSettingsSignalArgs{
+			UserID:   params.RoomCreatorUserID,
+			Settings: settingsWithoutConstraints,
+		})
+	}, creatorRemovesConstraints)
 
-	defer resetMock()
+	checkConstraintsHaveBeenRemoved := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.False(mtvState.RoomHasTimeAndPositionConstraints)
+		s.Nil(mtvState.TimeConstraintIsValid)
+		s.Nil(mtvState.UserRelatedInformation.UserFitsPositionConstraint)
+	}, checkConstraintsHaveBeenRemoved)
+
+	waitAfterConstraintEnd := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Nil(mtvState.TimeConstraintIsValid)
+	}, waitAfterConstraintEnd)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("end equal now", applicationErr.Error())
-
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomFailPlayingModeIsInvalid() {
+func (s *UnitTestSuite) Test_TransferOwnership() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		joiningUserID   = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4891,56 +7874,118 @@ func (s *UnitTestSuite) Test_MtvRoomFailPlayingModeIsInvalid() {
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	//mocking now
-	resetMock, _ := s.initTestEnv()
-	///
-	params.PlayingMode = "UNKNOWN_PLAYING_MODE"
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
-	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+	s.env.OnActivity(
+		activities.FetchTracksInformationActivity,
+		mock.Anything,
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTransferOwnership,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
-	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("PlayingMode is invalid", applicationErr.Error())
-}
+	checkCreatorOwnsTheRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-func (s *UnitTestSuite) Test_MtvRoomFailIsOpenOnlyInvitedUsersCanVoteTrueButIsOpenFalse() {
+		s.Equal(params.RoomCreatorUserID, mtvState.OwnerUserID)
+	}, checkCreatorOwnsTheRoom)
 
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	//mocking now
-	resetMock, _ := s.initTestEnv()
-	///
-	params.IsOpen = false
-	params.IsOpenOnlyInvitedUsersCanVote = true
+	// 1. A user who does not own the room can not transfer its ownership.
+	userWhoIsNotOwnerTransfersOwnership := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   joiningUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitTransferOwnershipSignal(shared_mtv.NewTransferOwnershipSignalArgs{
+			UserID:         joiningUserID,
+			NewOwnerUserID: joiningUserID,
+		})
+	}, userWhoIsNotOwnerTransfersOwnership)
 
-	defer resetMock()
+	checkOwnershipHasNotBeenTransferred := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.Equal(params.RoomCreatorUserID, mtvState.OwnerUserID)
+		s.False(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+	}, checkOwnershipHasNotBeenTransferred)
+
+	// 2. The owner can not transfer the ownership to a user who is not in the room.
+	ownerTransfersOwnershipToUnknownUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitTransferOwnershipSignal(shared_mtv.NewTransferOwnershipSignalArgs{
+			UserID:         params.RoomCreatorUserID,
+			NewOwnerUserID: faker.UUIDHyphenated(),
+		})
+	}, ownerTransfersOwnershipToUnknownUser)
+
+	checkOwnershipHasStillNotBeenTransferred := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(params.RoomCreatorUserID, mtvState.OwnerUserID)
+	}, checkOwnershipHasStillNotBeenTransferred)
+
+	// 3. The owner transfers the ownership to the joined user.
+	ownerTransfersOwnership := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitTransferOwnershipSignal(shared_mtv.NewTransferOwnershipSignalArgs{
+			UserID:         params.RoomCreatorUserID,
+			NewOwnerUserID: joiningUserID,
+		})
+	}, ownerTransfersOwnership)
+
+	checkOwnershipHasBeenTransferred := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.Equal(joiningUserID, mtvState.OwnerUserID)
+		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+
+		usersList := s.getUsersList()
+		s.Len(usersList, 2)
+		for _, user := range usersList {
+			isJoiningUser := user.UserID == joiningUserID
+
+			s.Equal(isJoiningUser, user.IsOwner)
+			s.Equal(!isJoiningUser, user.IsCreator)
+		}
+	}, checkOwnershipHasBeenTransferred)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("IsOpenOnlyInvitedUsersCanVote true but IsOpen false", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracksListUpdate() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_OwnershipIsTransferredWhenOwnerLeaves() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration  = 1 * time.Millisecond
+		oldestUserID     = faker.UUIDHyphenated()
+		controllerUserID = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4951,37 +7996,9 @@ func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracks
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-	tracksIDsToSuggest := []string{faker.UUIDHyphenated()}
-	tracksToSuggestMetadata := []shared.TrackMetadata{
-		{
-			ID:         tracksIDsToSuggest[0],
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
-
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
-	creatorUserID := params.RoomCreatorUserID
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.PlayingMode = shared_mtv.MtvPlayingModeDirect
+	params.OwnershipTransferPolicy = shared_mtv.MtvOwnershipTransferPolicyControlPermission
 
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
@@ -4991,111 +8008,69 @@ func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracks
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
-
-	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
-		mock.Anything,
-		tracksIDsToSuggest,
-		creatorUserID,
-		creatorDeviceID,
-	).Return(activities.FetchedTracksInformationWithInitiator{
-		Metadata: tracksToSuggestMetadata,
-		UserID:   creatorUserID,
-		DeviceID: creatorDeviceID,
-	}, nil).Once()
-
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
-
-	// This activity must be called when a user outside of the
-	// room area suggests a song.
 	s.env.OnActivity(
-		a.NotifySuggestOrVoteUpdateActivity,
+		a.JoinActivity,
 		mock.Anything,
 		mock.Anything,
-	).Return(nil).Once()
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.LeaveActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
 
-	init := defaultDuration
+	usersJoin := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &shared_mtv.FalseValue,
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   oldestUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   controllerUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
+			ToUpdateUserID:                    controllerUserID,
 			HasControlAndDelegationPermission: true,
-		}
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-		}
-
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		s.False(mtvState.Playing)
-		s.Equal(1, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-		s.True(mtvState.RoomHasTimeAndPositionConstraints)
-	}, init)
+		})
+	}, usersJoin)
 
-	creatorSuggestsATrack := defaultDuration
+	// 1. The creator leaves, the member with control permission becomes the owner.
+	creatorLeaves := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
-			TracksToSuggest: tracksIDsToSuggest,
-			UserID:          creatorUserID,
-			DeviceID:        creatorDeviceID,
-		})
-	}, creatorSuggestsATrack)
+		s.emitLeaveSignal(params.RoomCreatorUserID)
+	}, creatorLeaves)
 
-	checkSongHasBeenSuggested := defaultDuration
+	checkMemberWithControlPermissionOwnsTheRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-			// Song suggested by the creator
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracksToSuggestMetadata[0].ID,
-						Title:      tracksToSuggestMetadata[0].Title,
-						ArtistName: tracksToSuggestMetadata[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracksToSuggestMetadata[0].Duration.Milliseconds(),
-			},
-		}
+		s.Equal(controllerUserID, mtvState.OwnerUserID)
+		s.NotNil(mtvState.DelegationOwnerUserID)
+		s.Equal(controllerUserID, *mtvState.DelegationOwnerUserID)
+	}, checkMemberWithControlPermissionOwnsTheRoom)
 
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+	// 2. The new owner leaves, no member has control permission, the oldest member becomes the owner.
+	newOwnerLeaves := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitLeaveSignal(controllerUserID)
+	}, newOwnerLeaves)
 
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, checkSongHasBeenSuggested)
+	checkOldestMemberOwnsTheRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(oldestUserID)
+
+		s.Equal(oldestUserID, mtvState.OwnerUserID)
+		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+		s.NotNil(mtvState.DelegationOwnerUserID)
+		s.Equal(oldestUserID, *mtvState.DelegationOwnerUserID)
+	}, checkOldestMemberOwnsTheRoom)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -5104,8 +8079,16 @@ func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracks
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_KickUserPurgesVotes() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration  = 1 * time.Millisecond
+		kickedUserID     = faker.UUIDHyphenated()
+		kickedDeviceID   = faker.UUIDHyphenated()
+		otherUserID      = faker.UUIDHyphenated()
+		queuedTrackScore = 0
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -5114,29 +8097,15 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
-	tracksIDs := []string{tracks[0].ID}
-
-	params, _ := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
-
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 10)
 
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
@@ -5146,29 +8115,117 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+	s.env.OnActivity(
+		a.AcknowledgeKickUserActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeKickUserArgs) bool {
+			return args.KickedUserID == kickedUserID && args.KickedDeviceID == kickedDeviceID && !args.Banned
+		}),
+	).Return(nil).Once()
 
-	init := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		expectedDetails := shared_mtv.MtvRoomConstraintsDetails{
-			PhysicalConstraintEndsAt:   end.Format(time.RFC3339),
-			PhysicalConstraintStartsAt: start.Format(time.RFC3339),
-			PhysicalConstraintRadius:   5000,
-			RoomID:                     params.RoomID,
-			PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-				Lat: 42,
-				Lng: 42,
-			},
+	getQueuedTrackScore := func(mtvState shared_mtv.MtvRoomExposedState) int {
+		for _, track := range mtvState.Tracks {
+			if track.ID == tracks[1].ID {
+				return track.Score
+			}
 		}
 
-		details := s.getMtvRoomConstraintsDetails()
-		s.Equal(expectedDetails, details)
-	}, init)
+		return -1
+	}
+
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   kickedUserID,
+			DeviceID: kickedDeviceID,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   otherUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+	}, usersJoin)
+
+	saveQueuedTrackScore := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		queuedTrackScore = getQueuedTrackScore(mtvState)
+	}, saveQueuedTrackScore)
+
+	userVotes := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  kickedUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, userVotes)
+
+	checkVoteCounted := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(queuedTrackScore+1, getQueuedTrackScore(mtvState))
+	}, checkVoteCounted)
+
+	// 1. A member without control permission can not kick anyone.
+	kickFromMember := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitKickUserSignal(shared_mtv.NewKickUserSignalArgs{
+			UserID:       otherUserID,
+			KickedUserID: kickedUserID,
+		})
+	}, kickFromMember)
+
+	checkKickNotWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(3, mtvState.UsersLength)
+	}, checkKickNotWorked)
+
+	// 2. The owner kicks the user, their vote is removed from the queued track score.
+	kickFromOwner := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitKickUserSignal(shared_mtv.NewKickUserSignalArgs{
+			UserID:       params.RoomCreatorUserID,
+			KickedUserID: kickedUserID,
+		})
+	}, kickFromOwner)
+
+	checkKickWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(2, mtvState.UsersLength)
+		s.Equal(queuedTrackScore, getQueuedTrackScore(mtvState))
+	}, checkKickWorked)
+
+	// 3. A kicked user has not been banned and can join the room again.
+	kickedUserJoinsAgain := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   kickedUserID,
+			DeviceID: kickedDeviceID,
+		})
+	}, kickedUserJoinsAgain)
+
+	checkJoinWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(3, mtvState.UsersLength)
+	}, checkJoinWorked)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -5177,8 +8234,14 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConstraints() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_BanUserWhoIsNotInRoom() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+		bannedUserID    = faker.UUIDHyphenated()
+		bannedDeviceID  = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -5189,9 +8252,8 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConst
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-
 	params, _ := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
+
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
@@ -5200,20 +8262,65 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConst
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeKickUserActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeKickUserArgs) bool {
+			return args.KickedUserID == bannedUserID && args.KickedDeviceID == "" && args.Banned
+		}),
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
+	s.env.OnActivity(
+		a.RejectJoinActivity,
+		mock.Anything,
+		activities_mtv.RejectJoinArgs{
+			RoomID:   params.RoomID,
+			UserID:   bannedUserID,
+			DeviceID: bannedDeviceID,
+		},
+	).Return(nil).Once()
 
-	init := defaultDuration
+	// 1. The owner bans a user who never joined the room.
+	banUser := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		_, err := s.env.QueryWorkflow(shared_mtv.MtvGetRoomConstraintsDetails)
+		s.emitBanUserSignal(shared_mtv.NewBanUserSignalArgs{
+			UserID:       params.RoomCreatorUserID,
+			BannedUserID: bannedUserID,
+		})
+	}, banUser)
 
-		s.Error(err)
-		s.ErrorIs(err, ErrRoomDoesNotHaveConstraints)
-	}, init)
+	checkUsersAreUnchanged := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(1, mtvState.UsersLength)
+	}, checkUsersAreUnchanged)
+
+	// 2. The banned user join is rejected.
+	bannedUserJoins := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   bannedUserID,
+			DeviceID: bannedDeviceID,
+		})
+	}, bannedUserJoins)
+
+	checkJoinWasRejected := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(1, mtvState.UsersLength)
+	}, checkJoinWasRejected)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -5222,8 +8329,15 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConst
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomPanicAfterUnkownWorkflowSignal() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_BannedUserCanNotJoinRoom() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration  = 1 * time.Millisecond
+		bannedUserID     = faker.UUIDHyphenated()
+		bannedDeviceID   = faker.UUIDHyphenated()
+		controllerUserID = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -5234,9 +8348,8 @@ func (s *UnitTestSuite) Test_MtvRoomPanicAfterUnkownWorkflowSignal() {
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-
 	params, _ := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 200 * time.Millisecond
+
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
@@ -5245,30 +8358,117 @@ func (s *UnitTestSuite) Test_MtvRoomPanicAfterUnkownWorkflowSignal() {
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
 		tracksIDs,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeKickUserActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeKickUserArgs) bool {
+			return args.KickedUserID == bannedUserID && args.Banned
+		}),
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectJoinActivity,
+		mock.Anything,
+		activities_mtv.RejectJoinArgs{
+			RoomID:   params.RoomID,
+			UserID:   bannedUserID,
+			DeviceID: bannedDeviceID,
+		},
+	).Return(nil).Once()
 
-	init := defaultDuration
+	usersJoin := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUnkownSignal()
-	}, init)
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   bannedUserID,
+			DeviceID: bannedDeviceID,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   controllerUserID,
+			DeviceID: faker.UUIDHyphenated(),
+		})
+		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
+			ToUpdateUserID:                    controllerUserID,
+			HasControlAndDelegationPermission: true,
+		})
+	}, usersJoin)
+
+	// 1. Even with control permission the owner can not be banned.
+	banOwner := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitBanUserSignal(shared_mtv.NewBanUserSignalArgs{
+			UserID:       controllerUserID,
+			BannedUserID: params.RoomCreatorUserID,
+		})
+	}, banOwner)
+
+	checkOwnerIsStillInRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(3, mtvState.UsersLength)
+	}, checkOwnerIsStillInRoom)
+
+	// 2. A member with control permission bans a user.
+	banUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitBanUserSignal(shared_mtv.NewBanUserSignalArgs{
+			UserID:       controllerUserID,
+			BannedUserID: bannedUserID,
+		})
+	}, banUser)
+
+	checkBanWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(2, mtvState.UsersLength)
+	}, checkBanWorked)
+
+	// 3. The banned user join is rejected.
+	bannedUserJoinsAgain := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   bannedUserID,
+			DeviceID: bannedDeviceID,
+		})
+	}, bannedUserJoinsAgain)
+
+	checkJoinWasRejected := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(2, mtvState.UsersLength)
+	}, checkJoinWasRejected)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var panicError *temporal.PanicError
-	s.True(errors.As(err, &panicError))
-	s.Contains(panicError.Error(), ErrUnknownWorflowSignal.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomExitsAfterTerminateSignal() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_InvitationsToPrivateRoom() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration   = 1 * time.Millisecond
+		invitedUserID     = faker.UUIDHyphenated()
+		invitedDeviceID   = faker.UUIDHyphenated()
+		revokedUserID     = faker.UUIDHyphenated()
+		revokedDeviceID   = faker.UUIDHyphenated()
+		uninvitedUserID   = faker.UUIDHyphenated()
+		uninvitedDeviceID = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -5278,10 +8478,10 @@ func (s *UnitTestSuite) Test_MtvRoomExitsAfterTerminateSignal() {
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-	initialTracksIDs := []string{tracks[0].ID}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.IsOpen = false
 
-	params, _ := getWorkflowInitParams(initialTracksIDs, 1)
-	defaultDuration := 200 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
@@ -5289,24 +8489,116 @@ func (s *UnitTestSuite) Test_MtvRoomExitsAfterTerminateSignal() {
 	s.env.OnActivity(
 		activities.FetchTracksInformationActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		tracksIDs,
+	).Return(activities.FetchedTracksInformation{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeInviteUserActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeRevokeInvitationActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
 
-	init := defaultDuration
+	// 1. Only the owner or users with control permission can send invitations.
+	inviteFromUnknownUser := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitTerminateWorkflowSignal()
-	}, init)
+		s.emitInviteUserSignal(shared_mtv.NewInviteUserSignalArgs{
+			UserID:        uninvitedUserID,
+			InvitedUserID: uninvitedUserID,
+		})
+	}, inviteFromUnknownUser)
+
+	checkNoInvitation := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.Empty(s.getPendingInvitations())
+	}, checkNoInvitation)
+
+	ownerInvitesUsers := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitInviteUserSignal(shared_mtv.NewInviteUserSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: invitedUserID,
+		})
+		s.emitInviteUserSignal(shared_mtv.NewInviteUserSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: revokedUserID,
+		})
+	}, ownerInvitesUsers)
+
+	checkPendingInvitations := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedPendingInvitations := []shared_mtv.MtvRoomInvitation{
+			{
+				InvitedUserID:  invitedUserID,
+				InvitingUserID: params.RoomCreatorUserID,
+			},
+			{
+				InvitedUserID:  revokedUserID,
+				InvitingUserID: params.RoomCreatorUserID,
+			},
+		}
+
+		s.Equal(expectedPendingInvitations, s.getPendingInvitations())
+	}, checkPendingInvitations)
+
+	ownerRevokesInvitation := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitRevokeInvitationSignal(shared_mtv.NewRevokeInvitationSignalArgs{
+			UserID:        params.RoomCreatorUserID,
+			InvitedUserID: revokedUserID,
+		})
+	}, ownerRevokesInvitation)
+
+	// 2. Only invited users can join the private room.
+	usersJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   invitedUserID,
+			DeviceID: invitedDeviceID,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   revokedUserID,
+			DeviceID: revokedDeviceID,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:   uninvitedUserID,
+			DeviceID: uninvitedDeviceID,
+		})
+	}, usersJoin)
+
+	checkOnlyInvitedUserJoined := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(invitedUserID)
+
+		s.Equal(2, mtvState.UsersLength)
+		s.NotNil(mtvState.UserRelatedInformation)
+		s.True(mtvState.UserRelatedInformation.UserHasBeenInvited)
+		s.Empty(s.getPendingInvitations())
+	}, checkOnlyInvitedUserJoined)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Nil(err)
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
 func TestUnitTestSuite(t *testing.T) {
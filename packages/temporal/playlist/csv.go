@@ -0,0 +1,159 @@
+package playlist
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+//The playlist name is not part of the CSV document
+var csvHeader = []string{"id", "title", "artistName", "durationMs", "url"}
+
+func encodeCSV(w io.Writer, playlist Playlist, resolver TrackURLResolver) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, track := range playlist.Tracks {
+		trackURL, err := resolver.PlayableURL(track.ID)
+		if err != nil {
+			return err
+		}
+
+		record := []string{
+			track.ID,
+			track.Title,
+			track.ArtistName,
+			strconv.FormatInt(track.Duration.Milliseconds(), 10),
+			trackURL,
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+//Header names are compared once normalized, so that files exported
+//by other tools can be imported as long as they have an id or url column
+var csvColumnsAliases = map[string][]string{
+	"id":         {"id", "videoid", "youtubeid", "trackid"},
+	"title":      {"title"},
+	"artistName": {"artistname", "artist"},
+	"durationMs": {"durationms"},
+	"url":        {"url", "link", "location"},
+}
+
+func normalizeCSVHeaderName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimPrefix(name, "\ufeff")
+
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(name)
+}
+
+func getCSVColumnsIndexes(header []string) map[string]int {
+	columnsIndexes := make(map[string]int)
+
+	for index, name := range header {
+		normalizedName := normalizeCSVHeaderName(name)
+
+		for column, aliases := range csvColumnsAliases {
+			if _, alreadyFound := columnsIndexes[column]; alreadyFound {
+				continue
+			}
+
+			for _, alias := range aliases {
+				if normalizedName == alias {
+					columnsIndexes[column] = index
+				}
+			}
+		}
+	}
+
+	return columnsIndexes
+}
+
+func getCSVRecordValue(record []string, columnsIndexes map[string]int, column string) string {
+	index, exists := columnsIndexes[column]
+	if !exists || index >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[index])
+}
+
+func decodeCSV(r io.Reader, resolver TrackURLResolver, invalidEntries *[]InvalidEntry) (Playlist, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return Playlist{}, ErrMalformedDocument
+	}
+	if len(records) == 0 {
+		return Playlist{}, ErrMalformedDocument
+	}
+
+	columnsIndexes := getCSVColumnsIndexes(records[0])
+	_, hasIDColumn := columnsIndexes["id"]
+	_, hasURLColumn := columnsIndexes["url"]
+	if !hasIDColumn && !hasURLColumn {
+		return Playlist{}, ErrMalformedDocument
+	}
+
+	playlist := Playlist{
+		Tracks: make([]shared.TrackMetadata, 0, len(records)-1),
+	}
+
+	for recordIndex, record := range records[1:] {
+		//The header is the first row
+		row := recordIndex + 2
+
+		var duration time.Duration
+		if rawDuration := getCSVRecordValue(record, columnsIndexes, "durationMs"); rawDuration != "" {
+			durationInMilliseconds, err := strconv.ParseInt(rawDuration, 10, 64)
+			if err != nil {
+				if err := reportInvalidEntry(invalidEntries, row, strings.Join(record, ","), ErrMalformedDocument); err != nil {
+					return Playlist{}, err
+				}
+				continue
+			}
+			duration = time.Duration(durationInMilliseconds) * time.Millisecond
+		}
+
+		trackID := getCSVRecordValue(record, columnsIndexes, "id")
+		if trackID == "" {
+			trackURL := getCSVRecordValue(record, columnsIndexes, "url")
+
+			if trackID, err = trackIDFromURL(resolver, trackURL); err != nil {
+				if err := reportInvalidEntry(invalidEntries, row, strings.Join(record, ","), err); err != nil {
+					return Playlist{}, err
+				}
+				continue
+			}
+		} else if err := validateTrackID(resolver, trackID); err != nil {
+			if err := reportInvalidEntry(invalidEntries, row, strings.Join(record, ","), err); err != nil {
+				return Playlist{}, err
+			}
+			continue
+		}
+
+		playlist.Tracks = append(playlist.Tracks, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      getCSVRecordValue(record, columnsIndexes, "title"),
+			ArtistName: getCSVRecordValue(record, columnsIndexes, "artistName"),
+			Duration:   duration,
+		})
+	}
+
+	return playlist, nil
+}
@@ -0,0 +1,94 @@
+package playlist
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+//Must be incremented on every breaking change of jsonPlaylist
+const JSONDocumentVersion = 1
+
+type jsonPlaylist struct {
+	Version int         `json:"version"`
+	Name    string      `json:"name"`
+	Tracks  []jsonTrack `json:"tracks"`
+}
+
+type jsonTrack struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	ArtistName string `json:"artistName"`
+	DurationMs int64  `json:"durationMs"`
+	URL        string `json:"url"`
+}
+
+func encodeJSON(w io.Writer, playlist Playlist, resolver TrackURLResolver) error {
+	document := jsonPlaylist{
+		Version: JSONDocumentVersion,
+		Name:    playlist.Name,
+		Tracks:  make([]jsonTrack, 0, len(playlist.Tracks)),
+	}
+
+	for _, track := range playlist.Tracks {
+		trackURL, err := resolver.PlayableURL(track.ID)
+		if err != nil {
+			return err
+		}
+
+		document.Tracks = append(document.Tracks, jsonTrack{
+			ID:         track.ID,
+			Title:      track.Title,
+			ArtistName: track.ArtistName,
+			DurationMs: track.Duration.Milliseconds(),
+			URL:        trackURL,
+		})
+	}
+
+	return json.NewEncoder(w).Encode(document)
+}
+
+func decodeJSON(r io.Reader, resolver TrackURLResolver, invalidEntries *[]InvalidEntry) (Playlist, error) {
+	var document jsonPlaylist
+
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return Playlist{}, ErrMalformedDocument
+	}
+	if document.Version != JSONDocumentVersion {
+		return Playlist{}, ErrUnsupportedJSONVersion
+	}
+
+	playlist := Playlist{
+		Name:   document.Name,
+		Tracks: make([]shared.TrackMetadata, 0, len(document.Tracks)),
+	}
+
+	for trackIndex, track := range document.Tracks {
+		trackID := track.ID
+		if trackID == "" {
+			var err error
+			if trackID, err = trackIDFromURL(resolver, track.URL); err != nil {
+				if err := reportInvalidEntry(invalidEntries, trackIndex+1, track.URL, err); err != nil {
+					return Playlist{}, err
+				}
+				continue
+			}
+		} else if err := validateTrackID(resolver, trackID); err != nil {
+			if err := reportInvalidEntry(invalidEntries, trackIndex+1, trackID, err); err != nil {
+				return Playlist{}, err
+			}
+			continue
+		}
+
+		playlist.Tracks = append(playlist.Tracks, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      track.Title,
+			ArtistName: track.ArtistName,
+			Duration:   time.Duration(track.DurationMs) * time.Millisecond,
+		})
+	}
+
+	return playlist, nil
+}
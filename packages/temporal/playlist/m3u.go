@@ -0,0 +1,147 @@
+package playlist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+const (
+	m3uHeader            = "#EXTM3U"
+	m3uPlaylistDirective = "#PLAYLIST:"
+	m3uTrackDirective    = "#EXTINF:"
+	//Separates the artist name from the title in #EXTINF directives
+	m3uTitleSeparator = " - "
+)
+
+//Directives are line based, a line break would corrupt the document
+func sanitizeM3ULine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func encodeM3U8(w io.Writer, playlist Playlist, resolver TrackURLResolver) error {
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintln(writer, m3uHeader)
+	if playlist.Name != "" {
+		fmt.Fprintln(writer, m3uPlaylistDirective+sanitizeM3ULine(playlist.Name))
+	}
+
+	for _, track := range playlist.Tracks {
+		trackURL, err := resolver.PlayableURL(track.ID)
+		if err != nil {
+			return err
+		}
+
+		durationInSeconds := int64(track.Duration.Round(time.Second) / time.Second)
+		displayTitle := sanitizeM3ULine(track.Title)
+		if artistName := sanitizeM3ULine(track.ArtistName); artistName != "" {
+			displayTitle = artistName + m3uTitleSeparator + displayTitle
+		}
+
+		fmt.Fprintf(writer, "%s%d,%s\n", m3uTrackDirective, durationInSeconds, displayTitle)
+		fmt.Fprintln(writer, trackURL)
+	}
+
+	return writer.Flush()
+}
+
+func parseM3UTrackDirective(directive string) (shared.TrackMetadata, error) {
+	rawDuration, displayTitle, found := cut(directive, ",")
+	if !found {
+		return shared.TrackMetadata{}, ErrMalformedDocument
+	}
+
+	durationInSeconds, err := strconv.ParseFloat(strings.TrimSpace(rawDuration), 64)
+	if err != nil {
+		return shared.TrackMetadata{}, ErrMalformedDocument
+	}
+
+	var track shared.TrackMetadata
+	//A negative duration means the duration is unknown
+	if durationInSeconds > 0 {
+		track.Duration = time.Duration(durationInSeconds * float64(time.Second))
+	}
+
+	if artistName, title, found := cut(displayTitle, m3uTitleSeparator); found {
+		track.ArtistName = artistName
+		track.Title = title
+	} else {
+		track.Title = displayTitle
+	}
+
+	return track, nil
+}
+
+func decodeM3U8(r io.Reader, resolver TrackURLResolver, invalidEntries *[]InvalidEntry) (Playlist, error) {
+	var (
+		playlist     Playlist
+		pendingTrack *shared.TrackMetadata
+	)
+	playlist.Tracks = make([]shared.TrackMetadata, 0)
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+
+		switch {
+		case line == "" || line == m3uHeader:
+			continue
+		case strings.HasPrefix(line, m3uPlaylistDirective):
+			playlist.Name = strings.TrimPrefix(line, m3uPlaylistDirective)
+		case strings.HasPrefix(line, m3uTrackDirective):
+			track, err := parseM3UTrackDirective(strings.TrimPrefix(line, m3uTrackDirective))
+			if err != nil {
+				if err := reportInvalidEntry(invalidEntries, lineNumber, line, err); err != nil {
+					return Playlist{}, err
+				}
+				pendingTrack = nil
+				continue
+			}
+			pendingTrack = &track
+		case strings.HasPrefix(line, "#"):
+			//Unsupported directives and comments are ignored
+			continue
+		default:
+			trackID, err := trackIDFromURL(resolver, line)
+			if err != nil {
+				if err := reportInvalidEntry(invalidEntries, lineNumber, line, err); err != nil {
+					return Playlist{}, err
+				}
+				pendingTrack = nil
+				continue
+			}
+
+			var track shared.TrackMetadata
+			if pendingTrack != nil {
+				track = *pendingTrack
+				pendingTrack = nil
+			}
+			track.ID = trackID
+
+			playlist.Tracks = append(playlist.Tracks, track)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Playlist{}, err
+	}
+
+	return playlist, nil
+}
+
+//Equivalent to strings.Cut which is not available in go 1.16
+func cut(s string, sep string) (before string, after string, found bool) {
+	if index := strings.Index(s, sep); index >= 0 {
+		return s[:index], s[index+len(sep):], true
+	}
+
+	return s, "", false
+}
@@ -0,0 +1,265 @@
+package playlist
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+type Format string
+
+const (
+	FormatM3U8 Format = "m3u8"
+	FormatXSPF Format = "xspf"
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+//Format used when the client does not express any preference
+const DefaultFormat = FormatJSON
+
+var (
+	ErrUnknownFormat          = errors.New("unknown playlist format")
+	ErrInvalidTrackURL        = errors.New("track location does not reference a playable track")
+	ErrInvalidTrackID         = errors.New("track id does not reference a playable track")
+	ErrMalformedDocument      = errors.New("malformed playlist document")
+	ErrUnsupportedJSONVersion = errors.New("unsupported playlist json document version")
+)
+
+//The first media type of each format is the one sent back as Content-Type
+var formatsMediaTypes = map[Format][]string{
+	FormatM3U8: {"audio/x-mpegurl", "audio/mpegurl", "application/vnd.apple.mpegurl", "application/x-mpegurl"},
+	FormatXSPF: {"application/xspf+xml"},
+	FormatCSV:  {"text/csv"},
+	FormatJSON: {"application/json"},
+}
+
+var formatsFileExtensions = map[Format]string{
+	FormatM3U8: "m3u8",
+	FormatXSPF: "xspf",
+	FormatCSV:  "csv",
+	FormatJSON: "json",
+}
+
+func (f Format) IsValid() bool {
+	_, exists := formatsMediaTypes[f]
+
+	return exists
+}
+
+func (f Format) ContentType() string {
+	mediaTypes, exists := formatsMediaTypes[f]
+	if !exists {
+		return ""
+	}
+
+	if f == FormatJSON {
+		return mediaTypes[0]
+	}
+	return mediaTypes[0] + "; charset=utf-8"
+}
+
+func (f Format) FileExtension() string {
+	return formatsFileExtensions[f]
+}
+
+func ParseFormat(value string) (Format, error) {
+	format := Format(strings.ToLower(strings.TrimSpace(value)))
+	//m3u is commonly used as an alias of its UTF-8 variant
+	if format == "m3u" {
+		format = FormatM3U8
+	}
+
+	if !format.IsValid() {
+		return "", ErrUnknownFormat
+	}
+
+	return format, nil
+}
+
+type acceptedMediaType struct {
+	MediaType string
+	Quality   float64
+}
+
+//Returns the format matching the Accept header with the highest quality.
+//Wildcards resolve to the DefaultFormat.
+func NegotiateFormat(accept string) (Format, error) {
+	if strings.TrimSpace(accept) == "" {
+		return DefaultFormat, nil
+	}
+
+	acceptedMediaTypes := make([]acceptedMediaType, 0)
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if rawQuality, exists := params["q"]; exists {
+			parsedQuality, err := strconv.ParseFloat(rawQuality, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsedQuality
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		acceptedMediaTypes = append(acceptedMediaTypes, acceptedMediaType{
+			MediaType: mediaType,
+			Quality:   quality,
+		})
+	}
+
+	sort.SliceStable(acceptedMediaTypes, func(i, j int) bool {
+		return acceptedMediaTypes[i].Quality > acceptedMediaTypes[j].Quality
+	})
+
+	for _, accepted := range acceptedMediaTypes {
+		if accepted.MediaType == "*/*" {
+			return DefaultFormat, nil
+		}
+
+		for format, mediaTypes := range formatsMediaTypes {
+			for _, mediaType := range mediaTypes {
+				if accepted.MediaType == mediaType {
+					return format, nil
+				}
+			}
+		}
+	}
+
+	return "", ErrUnknownFormat
+}
+
+type Playlist struct {
+	Name   string
+	Tracks []shared.TrackMetadata
+}
+
+//Tracks ids are checked and tracks locations are built and parsed
+//by the tracks providers, see providers.Registry
+type TrackURLResolver interface {
+	ValidateTrackID(trackID string) error
+	PlayableURL(trackID string) (string, error)
+	TrackIDFromURL(rawURL string) (string, error)
+}
+
+func Encode(w io.Writer, format Format, playlist Playlist, resolver TrackURLResolver) error {
+	switch format {
+	case FormatM3U8:
+		return encodeM3U8(w, playlist, resolver)
+	case FormatXSPF:
+		return encodeXSPF(w, playlist, resolver)
+	case FormatCSV:
+		return encodeCSV(w, playlist, resolver)
+	case FormatJSON:
+		return encodeJSON(w, playlist, resolver)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+//Fails on the first invalid entry of the document
+func Decode(r io.Reader, format Format, resolver TrackURLResolver) (Playlist, error) {
+	return decode(r, format, resolver, nil)
+}
+
+//Entries are invalid when their track can not be identified.
+//Position is the 1-based line number for M3U8 and CSV documents
+//and the 1-based track index for XSPF and JSON documents.
+type InvalidEntry struct {
+	Position int    `json:"position"`
+	Value    string `json:"value"`
+	Reason   string `json:"reason"`
+}
+
+type ExtractedVideosIDs struct {
+	VideosIDs      []string
+	InvalidEntries []InvalidEntry
+}
+
+//Unlike Decode, invalid entries are collected instead of failing the whole
+//document, an error is still returned if the document can not be parsed.
+//Duplicated videos IDs are only kept once.
+func ExtractVideosIDs(r io.Reader, format Format, resolver TrackURLResolver) (ExtractedVideosIDs, error) {
+	invalidEntries := make([]InvalidEntry, 0)
+
+	decodedPlaylist, err := decode(r, format, resolver, &invalidEntries)
+	if err != nil {
+		return ExtractedVideosIDs{}, err
+	}
+
+	videosIDs := make([]string, 0, len(decodedPlaylist.Tracks))
+	alreadyExtractedVideosIDs := make(map[string]bool)
+	for _, track := range decodedPlaylist.Tracks {
+		if alreadyExtractedVideosIDs[track.ID] {
+			continue
+		}
+
+		alreadyExtractedVideosIDs[track.ID] = true
+		videosIDs = append(videosIDs, track.ID)
+	}
+
+	return ExtractedVideosIDs{
+		VideosIDs:      videosIDs,
+		InvalidEntries: invalidEntries,
+	}, nil
+}
+
+//When invalidEntries is nil the decoding is strict
+func decode(r io.Reader, format Format, resolver TrackURLResolver, invalidEntries *[]InvalidEntry) (Playlist, error) {
+	switch format {
+	case FormatM3U8:
+		return decodeM3U8(r, resolver, invalidEntries)
+	case FormatXSPF:
+		return decodeXSPF(r, resolver, invalidEntries)
+	case FormatCSV:
+		return decodeCSV(r, resolver, invalidEntries)
+	case FormatJSON:
+		return decodeJSON(r, resolver, invalidEntries)
+	default:
+		return Playlist{}, ErrUnknownFormat
+	}
+}
+
+//Returns the error to abort a strict decoding, otherwise collects the entry
+func reportInvalidEntry(invalidEntries *[]InvalidEntry, position int, value string, err error) error {
+	if invalidEntries == nil {
+		return err
+	}
+
+	*invalidEntries = append(*invalidEntries, InvalidEntry{
+		Position: position,
+		Value:    value,
+		Reason:   err.Error(),
+	})
+	return nil
+}
+
+//Locations that no provider recognizes are reported as ErrInvalidTrackURL
+func trackIDFromURL(resolver TrackURLResolver, rawURL string) (string, error) {
+	trackID, err := resolver.TrackIDFromURL(rawURL)
+	if err != nil {
+		return "", ErrInvalidTrackURL
+	}
+
+	return trackID, nil
+}
+
+//Ids that no provider accepts are reported as ErrInvalidTrackID
+func validateTrackID(resolver TrackURLResolver, trackID string) error {
+	if err := resolver.ValidateTrackID(trackID); err != nil {
+		return ErrInvalidTrackID
+	}
+
+	return nil
+}
@@ -0,0 +1,365 @@
+package playlist_test
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/playlist"
+	"github.com/AdonisEnProvence/MusicRoom/providers"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+
+	tracksProviders *providers.Registry
+}
+
+const localCatalog = `{"tracks":[{"id":"intro","title":"Intro","artistName":"The Band","durationMs":90000,"url":"https://cdn.example.com/intro.mp3"}]}`
+
+func (s *UnitTestSuite) SetupTest() {
+	localCatalogProvider, err := providers.NewLocalCatalogProvider(strings.NewReader(localCatalog))
+	s.Require().NoError(err)
+
+	s.tracksProviders = providers.NewRegistry(providers.NewYouTubeProvider(""), localCatalogProvider)
+}
+
+var allFormats = []playlist.Format{
+	playlist.FormatM3U8,
+	playlist.FormatXSPF,
+	playlist.FormatCSV,
+	playlist.FormatJSON,
+}
+
+const youtubeVideoIDAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+func generateYouTubeVideoID() string {
+	videoID := make([]byte, 11)
+	for index := range videoID {
+		videoID[index] = youtubeVideoIDAlphabet[rand.Intn(len(youtubeVideoIDAlphabet))]
+	}
+
+	return string(videoID)
+}
+
+func generatePlaylist(tracksCount int) playlist.Playlist {
+	tracks := make([]shared.TrackMetadata, 0, tracksCount)
+
+	for index := 0; index < tracksCount; index++ {
+		tracks = append(tracks, shared.TrackMetadata{
+			ID:         generateYouTubeVideoID(),
+			Title:      faker.Sentence(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+
+	return playlist.Playlist{
+		Name:   faker.Sentence(),
+		Tracks: tracks,
+	}
+}
+
+func (s *UnitTestSuite) Test_EncodedPlaylistCanBeDecoded() {
+	originalPlaylist := generatePlaylist(5)
+
+	for _, format := range allFormats {
+		var buffer bytes.Buffer
+
+		err := playlist.Encode(&buffer, format, originalPlaylist, s.tracksProviders)
+		s.NoError(err, format)
+
+		decodedPlaylist, err := playlist.Decode(&buffer, format, s.tracksProviders)
+		s.NoError(err, format)
+
+		expectedPlaylist := originalPlaylist
+		//The CSV document only contains the tracks
+		if format == playlist.FormatCSV {
+			expectedPlaylist.Name = ""
+		}
+		s.Equal(expectedPlaylist, decodedPlaylist, format)
+	}
+}
+
+func (s *UnitTestSuite) Test_EmptyPlaylistCanBeEncodedAndDecoded() {
+	originalPlaylist := playlist.Playlist{
+		Name:   faker.Word(),
+		Tracks: []shared.TrackMetadata{},
+	}
+
+	for _, format := range allFormats {
+		var buffer bytes.Buffer
+
+		err := playlist.Encode(&buffer, format, originalPlaylist, s.tracksProviders)
+		s.NoError(err, format)
+
+		decodedPlaylist, err := playlist.Decode(&buffer, format, s.tracksProviders)
+		s.NoError(err, format)
+		s.Empty(decodedPlaylist.Tracks, format)
+	}
+}
+
+func (s *UnitTestSuite) Test_TracksWithSpecialCharactersSurviveRoundTrip() {
+	originalPlaylist := playlist.Playlist{
+		Name: "Road trip, \"summer\" & <friends>",
+		Tracks: []shared.TrackMetadata{
+			{
+				ID:         "dQw4w9WgXcQ",
+				Title:      "Never Gonna Give You Up, \"Remastered\" <4K>",
+				ArtistName: "Rick Astley & Friends",
+				Duration:   213 * time.Second,
+			},
+		},
+	}
+
+	for _, format := range allFormats {
+		var buffer bytes.Buffer
+
+		err := playlist.Encode(&buffer, format, originalPlaylist, s.tracksProviders)
+		s.NoError(err, format)
+
+		decodedPlaylist, err := playlist.Decode(&buffer, format, s.tracksProviders)
+		s.NoError(err, format)
+		s.Equal(originalPlaylist.Tracks, decodedPlaylist.Tracks, format)
+	}
+}
+
+func (s *UnitTestSuite) Test_M3U8ContainsExtinfDurationsAndYouTubeURLs() {
+	originalPlaylist := playlist.Playlist{
+		Name: "My playlist",
+		Tracks: []shared.TrackMetadata{
+			{
+				ID:         "dQw4w9WgXcQ",
+				Title:      "Never Gonna Give You Up",
+				ArtistName: "Rick Astley",
+				Duration:   213 * time.Second,
+			},
+		},
+	}
+	var buffer bytes.Buffer
+
+	err := playlist.Encode(&buffer, playlist.FormatM3U8, originalPlaylist, s.tracksProviders)
+	s.NoError(err)
+
+	expectedDocument := strings.Join([]string{
+		"#EXTM3U",
+		"#PLAYLIST:My playlist",
+		"#EXTINF:213,Rick Astley - Never Gonna Give You Up",
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		"",
+	}, "\n")
+	s.Equal(expectedDocument, buffer.String())
+}
+
+func (s *UnitTestSuite) Test_TracksLocationsAreResolvedByTheirProvider() {
+	originalPlaylist := playlist.Playlist{
+		Name: "Mixed sources",
+		Tracks: []shared.TrackMetadata{
+			{
+				ID:         "dQw4w9WgXcQ",
+				Title:      "Never Gonna Give You Up",
+				ArtistName: "Rick Astley",
+				Duration:   213 * time.Second,
+			},
+			{
+				ID:         "local:intro",
+				Title:      "Intro",
+				ArtistName: "The Band",
+				Duration:   90 * time.Second,
+			},
+		},
+	}
+	var buffer bytes.Buffer
+
+	err := playlist.Encode(&buffer, playlist.FormatXSPF, originalPlaylist, s.tracksProviders)
+	s.NoError(err)
+	s.Contains(buffer.String(), "<location>https://cdn.example.com/intro.mp3</location>")
+
+	decodedPlaylist, err := playlist.Decode(&buffer, playlist.FormatXSPF, s.tracksProviders)
+	s.NoError(err)
+	s.Equal(originalPlaylist, decodedPlaylist)
+
+	//Tracks whose location can not be built are not exported
+	err = playlist.Encode(&buffer, playlist.FormatM3U8, playlist.Playlist{
+		Tracks: []shared.TrackMetadata{{ID: "local:outro"}},
+	}, s.tracksProviders)
+	s.ErrorIs(err, providers.ErrTrackNotInCatalog)
+}
+
+func (s *UnitTestSuite) Test_M3U8DecodesPlainPlaylists() {
+	document := strings.Join([]string{
+		"https://youtu.be/dQw4w9WgXcQ",
+		"# a comment",
+		"https://www.youtube.com/watch?v=9bZkp7q19f0&t=42",
+	}, "\n")
+
+	decodedPlaylist, err := playlist.Decode(strings.NewReader(document), playlist.FormatM3U8, s.tracksProviders)
+	s.NoError(err)
+	s.Equal([]shared.TrackMetadata{
+		{ID: "dQw4w9WgXcQ"},
+		{ID: "9bZkp7q19f0"},
+	}, decodedPlaylist.Tracks)
+}
+
+func (s *UnitTestSuite) Test_DecodingRejectsNonYouTubeLocations() {
+	document := strings.Join([]string{
+		"#EXTM3U",
+		"#EXTINF:120,Artist - Title",
+		"https://example.com/song.mp3",
+	}, "\n")
+
+	_, err := playlist.Decode(strings.NewReader(document), playlist.FormatM3U8, s.tracksProviders)
+	s.ErrorIs(err, playlist.ErrInvalidTrackURL)
+}
+
+func (s *UnitTestSuite) Test_JSONDecodingRejectsUnknownVersion() {
+	document := `{"version":42,"name":"playlist","tracks":[]}`
+
+	_, err := playlist.Decode(strings.NewReader(document), playlist.FormatJSON, s.tracksProviders)
+	s.ErrorIs(err, playlist.ErrUnsupportedJSONVersion)
+}
+
+func (s *UnitTestSuite) Test_CSVDecodingRejectsUnknownHeader() {
+	document := "identifier,name\nabc,def\n"
+
+	_, err := playlist.Decode(strings.NewReader(document), playlist.FormatCSV, s.tracksProviders)
+	s.ErrorIs(err, playlist.ErrMalformedDocument)
+}
+
+func (s *UnitTestSuite) Test_NegotiateFormat() {
+	testCases := []struct {
+		Accept         string
+		ExpectedFormat playlist.Format
+	}{
+		{Accept: "", ExpectedFormat: playlist.DefaultFormat},
+		{Accept: "*/*", ExpectedFormat: playlist.DefaultFormat},
+		{Accept: "audio/x-mpegurl", ExpectedFormat: playlist.FormatM3U8},
+		{Accept: "application/vnd.apple.mpegurl", ExpectedFormat: playlist.FormatM3U8},
+		{Accept: "application/xspf+xml", ExpectedFormat: playlist.FormatXSPF},
+		{Accept: "text/html, text/csv;q=0.9, */*;q=0.1", ExpectedFormat: playlist.FormatCSV},
+		{Accept: "application/json;q=0.5, application/xspf+xml", ExpectedFormat: playlist.FormatXSPF},
+	}
+
+	for _, testCase := range testCases {
+		format, err := playlist.NegotiateFormat(testCase.Accept)
+		s.NoError(err, testCase.Accept)
+		s.Equal(testCase.ExpectedFormat, format, testCase.Accept)
+	}
+
+	_, err := playlist.NegotiateFormat("text/html")
+	s.ErrorIs(err, playlist.ErrUnknownFormat)
+}
+
+func (s *UnitTestSuite) Test_ParseFormat() {
+	format, err := playlist.ParseFormat("M3U")
+	s.NoError(err)
+	s.Equal(playlist.FormatM3U8, format)
+
+	_, err = playlist.ParseFormat("wpl")
+	s.ErrorIs(err, playlist.ErrUnknownFormat)
+}
+
+func (s *UnitTestSuite) Test_ExtractVideosIDsCollectsInvalidEntries() {
+	document := strings.Join([]string{
+		"#EXTM3U",
+		"#EXTINF:120,Artist - Title",
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		"#EXTINF:180,Another artist - Another title",
+		"https://example.com/song.mp3",
+		"https://youtu.be/9bZkp7q19f0",
+		"https://youtu.be/dQw4w9WgXcQ",
+	}, "\n")
+
+	extracted, err := playlist.ExtractVideosIDs(strings.NewReader(document), playlist.FormatM3U8, s.tracksProviders)
+	s.NoError(err)
+	s.Equal([]string{"dQw4w9WgXcQ", "9bZkp7q19f0"}, extracted.VideosIDs)
+	s.Equal([]playlist.InvalidEntry{
+		{
+			Position: 5,
+			Value:    "https://example.com/song.mp3",
+			Reason:   playlist.ErrInvalidTrackURL.Error(),
+		},
+	}, extracted.InvalidEntries)
+}
+
+func (s *UnitTestSuite) Test_ExtractVideosIDsFromForeignCSV() {
+	document := strings.Join([]string{
+		"Artist,Video ID,Link",
+		"Rick Astley,dQw4w9WgXcQ,",
+		"PSY,,https://www.youtube.com/watch?v=9bZkp7q19f0",
+		"Unknown,,not a url",
+	}, "\n")
+
+	extracted, err := playlist.ExtractVideosIDs(strings.NewReader(document), playlist.FormatCSV, s.tracksProviders)
+	s.NoError(err)
+	s.Equal([]string{"dQw4w9WgXcQ", "9bZkp7q19f0"}, extracted.VideosIDs)
+	s.Len(extracted.InvalidEntries, 1)
+	s.Equal(4, extracted.InvalidEntries[0].Position)
+}
+
+func (s *UnitTestSuite) Test_ExtractVideosIDsFromJSONURLs() {
+	document := `{"version":1,"name":"playlist","tracks":[{"url":"https://youtu.be/dQw4w9WgXcQ"},{"title":"no id"}]}`
+
+	extracted, err := playlist.ExtractVideosIDs(strings.NewReader(document), playlist.FormatJSON, s.tracksProviders)
+	s.NoError(err)
+	s.Equal([]string{"dQw4w9WgXcQ"}, extracted.VideosIDs)
+	s.Len(extracted.InvalidEntries, 1)
+	s.Equal(2, extracted.InvalidEntries[0].Position)
+}
+
+func (s *UnitTestSuite) Test_InvalidTracksIDsAreReported() {
+	csvDocument := strings.Join([]string{
+		"id,title",
+		"dQw4w9WgXcQ,Title",
+		"not a video id,Title",
+		"local:,Title",
+		"local:intro,Intro",
+	}, "\n")
+
+	extracted, err := playlist.ExtractVideosIDs(strings.NewReader(csvDocument), playlist.FormatCSV, s.tracksProviders)
+	s.NoError(err)
+	s.Equal([]string{"dQw4w9WgXcQ", "local:intro"}, extracted.VideosIDs)
+	s.Equal([]playlist.InvalidEntry{
+		{
+			Position: 3,
+			Value:    "not a video id,Title",
+			Reason:   playlist.ErrInvalidTrackID.Error(),
+		},
+		{
+			Position: 4,
+			Value:    "local:,Title",
+			Reason:   playlist.ErrInvalidTrackID.Error(),
+		},
+	}, extracted.InvalidEntries)
+
+	jsonDocument := `{"version":1,"name":"playlist","tracks":[{"id":"unknown:abc"},{"id":"dQw4w9WgXcQ"}]}`
+
+	extracted, err = playlist.ExtractVideosIDs(strings.NewReader(jsonDocument), playlist.FormatJSON, s.tracksProviders)
+	s.NoError(err)
+	s.Equal([]string{"dQw4w9WgXcQ"}, extracted.VideosIDs)
+	s.Equal([]playlist.InvalidEntry{
+		{
+			Position: 1,
+			Value:    "unknown:abc",
+			Reason:   playlist.ErrInvalidTrackID.Error(),
+		},
+	}, extracted.InvalidEntries)
+
+	_, err = playlist.Decode(strings.NewReader(jsonDocument), playlist.FormatJSON, s.tracksProviders)
+	s.ErrorIs(err, playlist.ErrInvalidTrackID)
+}
+
+func (s *UnitTestSuite) Test_ExtractVideosIDsFailsOnUnparsableDocument() {
+	_, err := playlist.ExtractVideosIDs(strings.NewReader("<playlist"), playlist.FormatXSPF, s.tracksProviders)
+	s.ErrorIs(err, playlist.ErrMalformedDocument)
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
@@ -0,0 +1,100 @@
+package playlist
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+const xspfNamespace = "http://xspf.org/ns/0/"
+
+//See https://xspf.org/spec
+type xspfPlaylist struct {
+	XMLName   xml.Name    `xml:"http://xspf.org/ns/0/ playlist"`
+	Version   string      `xml:"version,attr"`
+	Title     string      `xml:"title,omitempty"`
+	TrackList []xspfTrack `xml:"trackList>track"`
+}
+
+type xspfTrack struct {
+	Location   string `xml:"location"`
+	Identifier string `xml:"identifier,omitempty"`
+	Title      string `xml:"title,omitempty"`
+	Creator    string `xml:"creator,omitempty"`
+	//Duration in milliseconds
+	Duration int64 `xml:"duration,omitempty"`
+}
+
+func encodeXSPF(w io.Writer, playlist Playlist, resolver TrackURLResolver) error {
+	document := xspfPlaylist{
+		Version:   "1",
+		Title:     playlist.Name,
+		TrackList: make([]xspfTrack, 0, len(playlist.Tracks)),
+	}
+
+	for _, track := range playlist.Tracks {
+		trackURL, err := resolver.PlayableURL(track.ID)
+		if err != nil {
+			return err
+		}
+
+		document.TrackList = append(document.TrackList, xspfTrack{
+			Location:   trackURL,
+			Identifier: track.ID,
+			Title:      track.Title,
+			Creator:    track.ArtistName,
+			Duration:   track.Duration.Milliseconds(),
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func decodeXSPF(r io.Reader, resolver TrackURLResolver, invalidEntries *[]InvalidEntry) (Playlist, error) {
+	var document xspfPlaylist
+
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return Playlist{}, ErrMalformedDocument
+	}
+	if document.XMLName.Space != xspfNamespace {
+		return Playlist{}, ErrMalformedDocument
+	}
+
+	playlist := Playlist{
+		Name:   document.Title,
+		Tracks: make([]shared.TrackMetadata, 0, len(document.TrackList)),
+	}
+
+	for trackIndex, track := range document.TrackList {
+		//The location is authoritative, the identifier is only a hint
+		trackID, err := trackIDFromURL(resolver, track.Location)
+		if err != nil {
+			if err := reportInvalidEntry(invalidEntries, trackIndex+1, track.Location, err); err != nil {
+				return Playlist{}, err
+			}
+			continue
+		}
+
+		playlist.Tracks = append(playlist.Tracks, shared.TrackMetadata{
+			ID:         trackID,
+			Title:      track.Title,
+			ArtistName: track.Creator,
+			Duration:   time.Duration(track.Duration) * time.Millisecond,
+		})
+	}
+
+	return playlist, nil
+}
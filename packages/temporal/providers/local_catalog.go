@@ -0,0 +1,123 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+const LocalCatalogProviderName = "local"
+
+var ErrTrackNotInCatalog = errors.New("track is not in the local catalog")
+
+//As {"tracks":[{"id":"intro","title":"Intro","artistName":"Band","durationMs":90000,"url":"https://cdn.example.com/intro.mp3"}]}
+type localCatalogDocument struct {
+	Tracks []localCatalogTrack `json:"tracks" validate:"dive"`
+}
+
+type localCatalogTrack struct {
+	ID         string `json:"id" validate:"required,excludesall=: "`
+	Title      string `json:"title" validate:"required"`
+	ArtistName string `json:"artistName"`
+	DurationMs int64  `json:"durationMs" validate:"min=0"`
+	URL        string `json:"url" validate:"required,url"`
+}
+
+//Serves tracks listed in a catalog file, such as self-hosted audio files
+type LocalCatalogProvider struct {
+	tracks   map[string]localCatalogTrack
+	idsByURL map[string]string
+}
+
+func NewLocalCatalogProvider(r io.Reader) (*LocalCatalogProvider, error) {
+	var document localCatalogDocument
+
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+	if err := validate.Struct(document); err != nil {
+		return nil, err
+	}
+
+	provider := &LocalCatalogProvider{
+		tracks:   make(map[string]localCatalogTrack, len(document.Tracks)),
+		idsByURL: make(map[string]string, len(document.Tracks)),
+	}
+	for _, track := range document.Tracks {
+		provider.tracks[track.ID] = track
+		provider.idsByURL[track.URL] = track.ID
+	}
+
+	return provider, nil
+}
+
+func LoadLocalCatalogProvider(path string) (*LocalCatalogProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return NewLocalCatalogProvider(file)
+}
+
+func (p *LocalCatalogProvider) Name() string {
+	return LocalCatalogProviderName
+}
+
+func (p *LocalCatalogProvider) ValidateID(id string) error {
+	if id == "" || strings.ContainsAny(id, ": ") {
+		return ErrInvalidTrackID
+	}
+
+	return nil
+}
+
+func (p *LocalCatalogProvider) FetchTracks(_ context.Context, ids []string) ([]FetchedTrack, error) {
+	fetchedTracks := make([]FetchedTrack, 0, len(ids))
+
+	for _, id := range ids {
+		track, exists := p.tracks[id]
+		if !exists {
+			fetchedTracks = append(fetchedTracks, FetchedTrack{
+				ID:     id,
+				Status: shared.TrackFetchStatusNotFound,
+			})
+			continue
+		}
+
+		fetchedTracks = append(fetchedTracks, FetchedTrack{
+			ID:     id,
+			Status: shared.TrackFetchStatusFound,
+			Metadata: shared.TrackMetadata{
+				ID:         track.ID,
+				Title:      track.Title,
+				ArtistName: track.ArtistName,
+				Duration:   time.Duration(track.DurationMs) * time.Millisecond,
+			},
+		})
+	}
+
+	return fetchedTracks, nil
+}
+
+func (p *LocalCatalogProvider) PlayableURL(id string) (string, error) {
+	track, exists := p.tracks[id]
+	if !exists {
+		return "", ErrTrackNotInCatalog
+	}
+
+	return track.URL, nil
+}
+
+func (p *LocalCatalogProvider) TrackIDFromURL(rawURL string) (string, bool) {
+	id, exists := p.idsByURL[strings.TrimSpace(rawURL)]
+
+	return id, exists
+}
@@ -0,0 +1,97 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown track provider")
+	ErrInvalidTrackID  = errors.New("invalid track id")
+	ErrUnknownTrackURL = errors.New("url does not reference a track of any provider")
+)
+
+//Metadata is only set when Status is shared.TrackFetchStatusFound
+type FetchedTrack struct {
+	ID       string
+	Status   shared.TrackFetchStatus
+	Metadata shared.TrackMetadata
+	//Set by the providers that know why an unplayable track can not be played
+	UnplayableReason string
+}
+
+//A TrackProvider is a source of playable tracks.
+//It only deals with ids local to the provider, without the provider prefix.
+type TrackProvider interface {
+	Name() string
+	ValidateID(id string) error
+	//Returns one fetched track per requested id, in the requested order
+	FetchTracks(ctx context.Context, ids []string) ([]FetchedTrack, error)
+	PlayableURL(id string) (string, error)
+	//Maps back a url built by PlayableURL, or an equivalent one, to its track
+	TrackIDFromURL(rawURL string) (string, bool)
+}
+
+const trackIDSeparator = ":"
+
+//Track ids are qualified as provider:id, e.g. local:my-track.
+//Ids without a provider prefix belong to the default provider,
+//which keeps the bare YouTube ids used before providers existed valid.
+type TrackID struct {
+	Provider string
+	ID       string
+}
+
+func ParseTrackID(rawTrackID string) TrackID {
+	separatorIndex := strings.Index(rawTrackID, trackIDSeparator)
+	if separatorIndex < 0 {
+		return TrackID{
+			ID: rawTrackID,
+		}
+	}
+
+	return TrackID{
+		Provider: rawTrackID[:separatorIndex],
+		ID:       rawTrackID[separatorIndex+len(trackIDSeparator):],
+	}
+}
+
+func (t TrackID) String() string {
+	if t.Provider == "" {
+		return t.ID
+	}
+
+	return t.Provider + trackIDSeparator + t.ID
+}
+
+//Workflows can not reach the registry of the worker, they rely on YouTube
+//being its default provider, see NewRegistryFromEnv.
+//Returns the bare id for YouTube tracks and the id as given otherwise,
+//so that abc and youtube:abc designate the same track in the rooms.
+func CanonicalTrackID(rawTrackID string) string {
+	trackID := ParseTrackID(rawTrackID)
+	if trackID.Provider != YouTubeProviderName || trackID.ID == "" {
+		return rawTrackID
+	}
+
+	return trackID.ID
+}
+
+func CanonicalTracksIDs(rawTracksIDs []string) []string {
+	tracksIDs := make([]string, 0, len(rawTracksIDs))
+	for _, rawTrackID := range rawTracksIDs {
+		tracksIDs = append(tracksIDs, CanonicalTrackID(rawTrackID))
+	}
+
+	return tracksIDs
+}
+
+func QualifyTrackID(provider string, id string) string {
+	return TrackID{
+		Provider: provider,
+		ID:       id,
+	}.String()
+}
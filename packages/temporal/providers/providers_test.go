@@ -0,0 +1,284 @@
+package providers_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/providers"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+//Serves every valid id and records the ids it has been asked for.
+//Ids starting with missing do not exist and those starting with
+//unplayable can not be played.
+type fakeProvider struct {
+	name         string
+	requestedIDs [][]string
+}
+
+func (p *fakeProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeProvider) ValidateID(id string) error {
+	if id == "" {
+		return providers.ErrInvalidTrackID
+	}
+
+	return nil
+}
+
+func (p *fakeProvider) FetchTracks(_ context.Context, ids []string) ([]providers.FetchedTrack, error) {
+	p.requestedIDs = append(p.requestedIDs, ids)
+
+	fetchedTracks := make([]providers.FetchedTrack, 0, len(ids))
+	for _, id := range ids {
+		switch {
+		case strings.HasPrefix(id, "missing"):
+			fetchedTracks = append(fetchedTracks, providers.FetchedTrack{
+				ID:     id,
+				Status: shared.TrackFetchStatusNotFound,
+			})
+		case strings.HasPrefix(id, "unplayable"):
+			fetchedTracks = append(fetchedTracks, providers.FetchedTrack{
+				ID:     id,
+				Status: shared.TrackFetchStatusUnplayable,
+			})
+		default:
+			fetchedTracks = append(fetchedTracks, providers.FetchedTrack{
+				ID:     id,
+				Status: shared.TrackFetchStatusFound,
+				Metadata: shared.TrackMetadata{
+					ID:    id,
+					Title: p.name + " " + id,
+				},
+			})
+		}
+	}
+
+	return fetchedTracks, nil
+}
+
+func (p *fakeProvider) PlayableURL(id string) (string, error) {
+	return "https://" + p.name + ".example.com/" + id, nil
+}
+
+func (p *fakeProvider) TrackIDFromURL(rawURL string) (string, bool) {
+	id := strings.TrimPrefix(rawURL, "https://"+p.name+".example.com/")
+
+	return id, id != rawURL && id != ""
+}
+
+const localCatalog = `{
+	"tracks": [
+		{
+			"id": "intro",
+			"title": "Intro",
+			"artistName": "The Band",
+			"durationMs": 90000,
+			"url": "https://cdn.example.com/intro.mp3"
+		}
+	]
+}`
+
+func (s *UnitTestSuite) Test_ParseTrackID() {
+	s.Equal(providers.TrackID{ID: "dQw4w9WgXcQ"}, providers.ParseTrackID("dQw4w9WgXcQ"))
+	s.Equal(providers.TrackID{Provider: "local", ID: "intro"}, providers.ParseTrackID("local:intro"))
+	s.Equal("local:intro", providers.QualifyTrackID("local", "intro"))
+	s.Equal("dQw4w9WgXcQ", providers.TrackID{ID: "dQw4w9WgXcQ"}.String())
+}
+
+func (s *UnitTestSuite) Test_RegistryFetchesMixedSourcesInRequestedOrder() {
+	defaultProvider := &fakeProvider{name: "default"}
+	otherProvider := &fakeProvider{name: "other"}
+	registry := providers.NewRegistry(defaultProvider, otherProvider)
+
+	metadata, err := registry.FetchTracksMetadata(context.Background(), []string{
+		"other:a",
+		"b",
+		"missing-c",
+		"unknown:d",
+		"default:e",
+		"b",
+		"other:",
+	})
+	s.NoError(err)
+
+	//Tracks of the default provider are returned with their bare id
+	s.Equal([]shared.TrackMetadata{
+		{ID: "other:a", Title: "other a", Provider: "other", URL: "https://other.example.com/a"},
+		{ID: "b", Title: "default b", Provider: "default", URL: "https://default.example.com/b"},
+		{ID: "e", Title: "default e", Provider: "default", URL: "https://default.example.com/e"},
+	}, metadata)
+	s.Equal([][]string{{"b", "missing-c", "e"}}, defaultProvider.requestedIDs)
+	s.Equal([][]string{{"a"}}, otherProvider.requestedIDs)
+}
+
+func (s *UnitTestSuite) Test_RegistryReportsAStatusForEveryRequestedTrack() {
+	defaultProvider := &fakeProvider{name: "default"}
+	registry := providers.NewRegistry(defaultProvider)
+
+	fetchedTracks, err := registry.FetchTracks(context.Background(), []string{
+		"a",
+		"missing-b",
+		"unknown:c",
+		"unplayable-d",
+		"default:a",
+		"missing-b",
+		"default:",
+	})
+	s.NoError(err)
+
+	statuses := make(map[string]shared.TrackFetchStatus, len(fetchedTracks))
+	fetchedIDs := make([]string, 0, len(fetchedTracks))
+	for _, fetchedTrack := range fetchedTracks {
+		statuses[fetchedTrack.ID] = fetchedTrack.Status
+		fetchedIDs = append(fetchedIDs, fetchedTrack.ID)
+	}
+
+	s.Equal([]string{"a", "missing-b", "unknown:c", "unplayable-d", "default:"}, fetchedIDs)
+	s.Equal(map[string]shared.TrackFetchStatus{
+		"a":            shared.TrackFetchStatusFound,
+		"missing-b":    shared.TrackFetchStatusNotFound,
+		"unknown:c":    shared.TrackFetchStatusInvalidID,
+		"unplayable-d": shared.TrackFetchStatusUnplayable,
+		"default:":     shared.TrackFetchStatusInvalidID,
+	}, statuses)
+	s.Equal("default a", fetchedTracks[0].Metadata.Title)
+}
+
+func (s *UnitTestSuite) Test_RegistryValidatesIDsAndBuildsURLs() {
+	registry := providers.NewRegistry(providers.NewYouTubeProvider(""))
+
+	url, err := registry.PlayableURL("dQw4w9WgXcQ")
+	s.NoError(err)
+	s.Equal("https://www.youtube.com/watch?v=dQw4w9WgXcQ", url)
+
+	s.NoError(registry.ValidateTrackID("youtube:dQw4w9WgXcQ"))
+	s.ErrorIs(registry.ValidateTrackID("not-a-youtube-id"), providers.ErrInvalidTrackID)
+	s.ErrorIs(registry.ValidateTrackID("local:intro"), providers.ErrUnknownProvider)
+}
+
+func (s *UnitTestSuite) Test_YouTubeProviderRequiresAnAPIKey() {
+	provider := providers.NewYouTubeProvider("")
+
+	_, err := provider.FetchTracks(context.Background(), []string{"dQw4w9WgXcQ"})
+	s.ErrorIs(err, providers.ErrInvalidGoogleAPIKey)
+}
+
+func (s *UnitTestSuite) Test_LocalCatalogProvider() {
+	provider, err := providers.NewLocalCatalogProvider(strings.NewReader(localCatalog))
+	s.NoError(err)
+
+	fetchedTracks, err := provider.FetchTracks(context.Background(), []string{"intro", "outro"})
+	s.NoError(err)
+	s.Equal([]providers.FetchedTrack{
+		{
+			ID:     "intro",
+			Status: shared.TrackFetchStatusFound,
+			Metadata: shared.TrackMetadata{
+				ID:         "intro",
+				Title:      "Intro",
+				ArtistName: "The Band",
+				Duration:   90 * time.Second,
+			},
+		},
+		{
+			ID:     "outro",
+			Status: shared.TrackFetchStatusNotFound,
+		},
+	}, fetchedTracks)
+
+	url, err := provider.PlayableURL("intro")
+	s.NoError(err)
+	s.Equal("https://cdn.example.com/intro.mp3", url)
+
+	_, err = provider.PlayableURL("outro")
+	s.ErrorIs(err, providers.ErrTrackNotInCatalog)
+}
+
+func (s *UnitTestSuite) Test_LocalCatalogProviderRejectsInvalidCatalog() {
+	_, err := providers.NewLocalCatalogProvider(strings.NewReader(`{"tracks":[{"id":"a:b","title":"Title","url":"https://cdn.example.com/a.mp3"}]}`))
+	s.Error(err)
+
+	_, err = providers.NewLocalCatalogProvider(strings.NewReader(`{"tracks":[{"id":"intro","title":"Intro"}]}`))
+	s.Error(err)
+}
+
+func (s *UnitTestSuite) Test_RoomsCanMixYouTubeAndLocalTracks() {
+	localCatalogProvider, err := providers.NewLocalCatalogProvider(strings.NewReader(localCatalog))
+	s.NoError(err)
+	youtubeProvider := &fakeProvider{name: providers.YouTubeProviderName}
+	registry := providers.NewRegistry(youtubeProvider, localCatalogProvider)
+
+	metadata, err := registry.FetchTracksMetadata(context.Background(), []string{"dQw4w9WgXcQ", "local:intro", "youtube:dQw4w9WgXcQ"})
+	s.NoError(err)
+	s.Len(metadata, 2)
+	s.Equal("dQw4w9WgXcQ", metadata[0].ID)
+	s.Equal("local:intro", metadata[1].ID)
+	s.Equal("Intro", metadata[1].Title)
+	s.Equal(providers.LocalCatalogProviderName, metadata[1].Provider)
+	s.Equal("https://cdn.example.com/intro.mp3", metadata[1].URL)
+
+	url, err := registry.PlayableURL("local:intro")
+	s.NoError(err)
+	s.Equal("https://cdn.example.com/intro.mp3", url)
+}
+
+func (s *UnitTestSuite) Test_TracksIDsAreCanonicalized() {
+	registry := providers.NewRegistry(providers.NewYouTubeProvider(""), &fakeProvider{name: providers.LocalCatalogProviderName})
+
+	for rawTrackID, expectedTrackID := range map[string]string{
+		"dQw4w9WgXcQ":         "dQw4w9WgXcQ",
+		"youtube:dQw4w9WgXcQ": "dQw4w9WgXcQ",
+		"local:intro":         "local:intro",
+	} {
+		s.Equal(expectedTrackID, providers.CanonicalTrackID(rawTrackID), rawTrackID)
+
+		trackID, err := registry.CanonicalTrackID(rawTrackID)
+		s.NoError(err, rawTrackID)
+		s.Equal(expectedTrackID, trackID, rawTrackID)
+	}
+
+	s.Equal("youtube:", providers.CanonicalTrackID("youtube:"))
+	_, err := registry.CanonicalTrackID("unknown:a")
+	s.ErrorIs(err, providers.ErrUnknownProvider)
+}
+
+func (s *UnitTestSuite) Test_RegistryMapsURLsBackToTracks() {
+	localCatalogProvider, err := providers.NewLocalCatalogProvider(strings.NewReader(localCatalog))
+	s.NoError(err)
+	registry := providers.NewRegistry(providers.NewYouTubeProvider(""), localCatalogProvider)
+
+	testCases := []struct {
+		URL             string
+		ExpectedTrackID string
+	}{
+		{URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", ExpectedTrackID: "dQw4w9WgXcQ"},
+		{URL: "https://youtu.be/dQw4w9WgXcQ", ExpectedTrackID: "dQw4w9WgXcQ"},
+		{URL: "https://music.youtube.com/watch?v=9bZkp7q19f0&t=42", ExpectedTrackID: "9bZkp7q19f0"},
+		{URL: "https://cdn.example.com/intro.mp3", ExpectedTrackID: "local:intro"},
+	}
+
+	for _, testCase := range testCases {
+		trackID, err := registry.TrackIDFromURL(testCase.URL)
+		s.NoError(err, testCase.URL)
+		s.Equal(testCase.ExpectedTrackID, trackID, testCase.URL)
+	}
+
+	for _, rawURL := range []string{"https://cdn.example.com/outro.mp3", "https://youtu.be/not-an-id", "not a url"} {
+		_, err := registry.TrackIDFromURL(rawURL)
+		s.ErrorIs(err, providers.ErrUnknownTrackURL, rawURL)
+	}
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
@@ -0,0 +1,264 @@
+package providers
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/AdonisEnProvence/MusicRoom/youtube"
+)
+
+type Registry struct {
+	defaultProvider string
+	providers       map[string]TrackProvider
+}
+
+//The default provider resolves the track ids without provider prefix
+func NewRegistry(defaultProvider TrackProvider, otherProviders ...TrackProvider) *Registry {
+	registry := &Registry{
+		defaultProvider: defaultProvider.Name(),
+		providers:       make(map[string]TrackProvider),
+	}
+
+	registry.Register(defaultProvider)
+	for _, provider := range otherProviders {
+		registry.Register(provider)
+	}
+
+	return registry
+}
+
+//YouTube is the default provider, the local catalog provider
+//is only registered when LOCAL_TRACKS_CATALOG_PATH is defined
+func NewRegistryFromEnv() (*Registry, error) {
+	playabilityPolicy, err := youtube.PlayabilityPolicyFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	registry := NewRegistry(NewYouTubeProviderWithPolicy(os.Getenv("GOOGLE_API_KEY"), playabilityPolicy))
+
+	if catalogPath := os.Getenv("LOCAL_TRACKS_CATALOG_PATH"); catalogPath != "" {
+		localCatalogProvider, err := LoadLocalCatalogProvider(catalogPath)
+		if err != nil {
+			return nil, err
+		}
+
+		registry.Register(localCatalogProvider)
+	}
+
+	return registry, nil
+}
+
+//Registering a provider with an already registered name replaces it
+func (r *Registry) Register(provider TrackProvider) {
+	r.providers[provider.Name()] = provider
+}
+
+func (r *Registry) Get(name string) (TrackProvider, bool) {
+	provider, exists := r.providers[name]
+
+	return provider, exists
+}
+
+func (r *Registry) resolve(rawTrackID string) (TrackProvider, TrackID, error) {
+	trackID := ParseTrackID(rawTrackID)
+
+	providerName := trackID.Provider
+	if providerName == "" {
+		providerName = r.defaultProvider
+	}
+
+	provider, exists := r.Get(providerName)
+	if !exists {
+		return nil, TrackID{}, ErrUnknownProvider
+	}
+	if err := provider.ValidateID(trackID.ID); err != nil {
+		return nil, TrackID{}, err
+	}
+
+	return provider, trackID, nil
+}
+
+func (r *Registry) ValidateTrackID(rawTrackID string) error {
+	_, _, err := r.resolve(rawTrackID)
+
+	return err
+}
+
+//Returns the id prefixed with the name of its provider, so that the ids
+//designating the same track, e.g. abc and youtube:abc, can be compared
+func (r *Registry) QualifiedTrackID(rawTrackID string) (string, error) {
+	provider, trackID, err := r.resolve(rawTrackID)
+	if err != nil {
+		return "", err
+	}
+
+	return QualifyTrackID(provider.Name(), trackID.ID), nil
+}
+
+//Returns the bare id for the tracks of the default provider
+//and the qualified id for the others
+func (r *Registry) CanonicalTrackID(rawTrackID string) (string, error) {
+	provider, trackID, err := r.resolve(rawTrackID)
+	if err != nil {
+		return "", err
+	}
+
+	return r.canonicalTrackID(provider, trackID), nil
+}
+
+func (r *Registry) canonicalTrackID(provider TrackProvider, trackID TrackID) string {
+	if provider.Name() == r.defaultProvider {
+		return trackID.ID
+	}
+
+	return QualifyTrackID(provider.Name(), trackID.ID)
+}
+
+func (r *Registry) PlayableURL(rawTrackID string) (string, error) {
+	provider, trackID, err := r.resolve(rawTrackID)
+	if err != nil {
+		return "", err
+	}
+
+	return provider.PlayableURL(trackID.ID)
+}
+
+//The default provider is asked first and its tracks ids are not qualified,
+//other providers are asked in the order of their names
+func (r *Registry) TrackIDFromURL(rawURL string) (string, error) {
+	providersNames := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		if name != r.defaultProvider {
+			providersNames = append(providersNames, name)
+		}
+	}
+	sort.Strings(providersNames)
+
+	if defaultProvider, exists := r.Get(r.defaultProvider); exists {
+		if id, found := defaultProvider.TrackIDFromURL(rawURL); found {
+			return id, nil
+		}
+	}
+
+	for _, name := range providersNames {
+		provider, _ := r.Get(name)
+
+		if id, found := provider.TrackIDFromURL(rawURL); found {
+			return QualifyTrackID(name, id), nil
+		}
+	}
+
+	return "", ErrUnknownTrackURL
+}
+
+//Returns one fetched track per requested track, in the order of tracksIDs
+//and with their canonical ids, see CanonicalTrackID. Ids that are malformed
+//or belong to an unknown provider are reported invalid, as they were given.
+func (r *Registry) FetchTracks(ctx context.Context, tracksIDs []string) ([]FetchedTrack, error) {
+	var (
+		providersOrder    = make([]string, 0)
+		idsByProvider     = make(map[string][]string)
+		requestedIDs      = make(map[string]bool)
+		fetchedByProvider = make(map[string]map[string]FetchedTrack)
+	)
+
+	for _, rawTrackID := range tracksIDs {
+		provider, trackID, err := r.resolve(rawTrackID)
+		if err != nil {
+			continue
+		}
+
+		providerName := provider.Name()
+		if _, exists := idsByProvider[providerName]; !exists {
+			providersOrder = append(providersOrder, providerName)
+		}
+
+		qualifiedTrackID := QualifyTrackID(providerName, trackID.ID)
+		if requestedIDs[qualifiedTrackID] {
+			continue
+		}
+		requestedIDs[qualifiedTrackID] = true
+		idsByProvider[providerName] = append(idsByProvider[providerName], trackID.ID)
+	}
+
+	for _, providerName := range providersOrder {
+		provider, _ := r.Get(providerName)
+
+		fetchedTracks, err := provider.FetchTracks(ctx, idsByProvider[providerName])
+		if err != nil {
+			return nil, err
+		}
+
+		fetchedByProvider[providerName] = make(map[string]FetchedTrack, len(fetchedTracks))
+		for _, fetchedTrack := range fetchedTracks {
+			fetchedByProvider[providerName][fetchedTrack.ID] = fetchedTrack
+		}
+	}
+
+	var (
+		fetchedTracks = make([]FetchedTrack, 0, len(tracksIDs))
+		returnedIDs   = make(map[string]bool)
+	)
+	for _, rawTrackID := range tracksIDs {
+		provider, trackID, err := r.resolve(rawTrackID)
+		if err != nil {
+			if returnedIDs[rawTrackID] {
+				continue
+			}
+			returnedIDs[rawTrackID] = true
+
+			fetchedTracks = append(fetchedTracks, FetchedTrack{
+				ID:     rawTrackID,
+				Status: shared.TrackFetchStatusInvalidID,
+			})
+			continue
+		}
+
+		//A track requested several times is only returned once
+		qualifiedTrackID := QualifyTrackID(provider.Name(), trackID.ID)
+		if returnedIDs[qualifiedTrackID] {
+			continue
+		}
+		returnedIDs[qualifiedTrackID] = true
+
+		fetchedTrack, exists := fetchedByProvider[provider.Name()][trackID.ID]
+		if !exists {
+			fetchedTrack = FetchedTrack{
+				Status: shared.TrackFetchStatusNotFound,
+			}
+		}
+
+		fetchedTrack.ID = r.canonicalTrackID(provider, trackID)
+		if fetchedTrack.Status == shared.TrackFetchStatusFound {
+			fetchedTrack.Metadata.ID = fetchedTrack.ID
+			fetchedTrack.Metadata.Provider = provider.Name()
+			//Clients can still play the tracks of the default provider from their id
+			fetchedTrack.Metadata.URL, _ = provider.PlayableURL(trackID.ID)
+		}
+		fetchedTracks = append(fetchedTracks, fetchedTrack)
+	}
+
+	return fetchedTracks, nil
+}
+
+//Returns the metadata of the found tracks, see FetchTracks
+func (r *Registry) FetchTracksMetadata(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	fetchedTracks, err := r.FetchTracks(ctx, tracksIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]shared.TrackMetadata, 0, len(fetchedTracks))
+	for _, fetchedTrack := range fetchedTracks {
+		if fetchedTrack.Status != shared.TrackFetchStatusFound {
+			continue
+		}
+
+		metadata = append(metadata, fetchedTrack.Metadata)
+	}
+
+	return metadata, nil
+}
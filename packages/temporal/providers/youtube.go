@@ -0,0 +1,150 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/AdonisEnProvence/MusicRoom/youtube"
+)
+
+const YouTubeProviderName = "youtube"
+
+var ErrInvalidGoogleAPIKey = errors.New("invalid Google API key")
+
+var youtubeVideoIDRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+type YouTubeProvider struct {
+	apiKey string
+	policy youtube.PlayabilityPolicy
+}
+
+//Uses the strictest playability policy
+func NewYouTubeProvider(apiKey string) *YouTubeProvider {
+	return NewYouTubeProviderWithPolicy(apiKey, youtube.PlayabilityPolicy{})
+}
+
+func NewYouTubeProviderWithPolicy(apiKey string, policy youtube.PlayabilityPolicy) *YouTubeProvider {
+	return &YouTubeProvider{
+		apiKey: apiKey,
+		policy: policy,
+	}
+}
+
+func (p *YouTubeProvider) Name() string {
+	return YouTubeProviderName
+}
+
+func (p *YouTubeProvider) ValidateID(id string) error {
+	if !youtubeVideoIDRegexp.MatchString(id) {
+		return ErrInvalidTrackID
+	}
+
+	return nil
+}
+
+func (p *YouTubeProvider) FetchTracks(ctx context.Context, ids []string) ([]FetchedTrack, error) {
+	fetchedTracks := make([]FetchedTrack, 0, len(ids))
+
+	if len(ids) == 0 {
+		return fetchedTracks, nil
+	}
+
+	if p.apiKey == "" {
+		return nil, ErrInvalidGoogleAPIKey
+	}
+
+	fetchedVideos, err := youtube.FetchVideosInformation(ctx, p.apiKey, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	videosByID := make(map[string]youtube.YoutubeVideo, len(fetchedVideos.Videos))
+	for _, video := range fetchedVideos.Videos {
+		videosByID[video.ID] = video
+	}
+
+	for _, id := range ids {
+		video, exists := videosByID[id]
+		if !exists {
+			fetchedTracks = append(fetchedTracks, FetchedTrack{
+				ID:     id,
+				Status: shared.TrackFetchStatusNotFound,
+			})
+			continue
+		}
+
+		fetchedTracks = append(fetchedTracks, p.videoToFetchedTrack(video))
+	}
+
+	return fetchedTracks, nil
+}
+
+//Videos rejected by the playability policy never reach the rooms
+func (p *YouTubeProvider) videoToFetchedTrack(video youtube.YoutubeVideo) FetchedTrack {
+	if reason := p.policy.UnplayableReason(video); reason != "" {
+		return FetchedTrack{
+			ID:               video.ID,
+			Status:           shared.TrackFetchStatusUnplayable,
+			UnplayableReason: string(reason),
+		}
+	}
+
+	parsedDuration, err := youtube.ParseVideoDuration(video)
+	if err != nil {
+		return FetchedTrack{
+			ID:     video.ID,
+			Status: shared.TrackFetchStatusDurationParseError,
+		}
+	}
+
+	return FetchedTrack{
+		ID:     video.ID,
+		Status: shared.TrackFetchStatusFound,
+		Metadata: shared.TrackMetadata{
+			ID:         video.ID,
+			Title:      video.Snippet.Title,
+			ArtistName: video.Snippet.ChannelTitle,
+			Duration:   parsedDuration,
+		},
+	}
+}
+
+func (p *YouTubeProvider) PlayableURL(id string) (string, error) {
+	if err := p.ValidateID(id); err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"v": {id},
+	}
+
+	return "https://www.youtube.com/watch?" + params.Encode(), nil
+}
+
+//Accepts youtube.com/watch?v= and youtu.be/ urls
+func (p *YouTubeProvider) TrackIDFromURL(rawURL string) (string, bool) {
+	parsedURL, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", false
+	}
+
+	var videoID string
+	switch strings.TrimPrefix(strings.ToLower(parsedURL.Host), "www.") {
+	case "youtube.com", "m.youtube.com", "music.youtube.com":
+		if parsedURL.Path == "/watch" {
+			videoID = parsedURL.Query().Get("v")
+		}
+	case "youtu.be":
+		videoID = strings.TrimPrefix(parsedURL.Path, "/")
+	}
+
+	if err := p.ValidateID(videoID); err != nil {
+		return "", false
+	}
+
+	return videoID, true
+}
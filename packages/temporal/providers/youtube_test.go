@@ -0,0 +1,81 @@
+package providers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/AdonisEnProvence/MusicRoom/youtube"
+	"github.com/stretchr/testify/assert"
+)
+
+func generatePlayableYoutubeVideo(id string) youtube.YoutubeVideo {
+	var video youtube.YoutubeVideo
+
+	video.ID = id
+	video.Snippet.Title = "Title"
+	video.Snippet.ChannelTitle = "Channel"
+	video.Snippet.LiveBroadcastContent = "none"
+	video.ContentDetails.Duration = "PT3M33S"
+	video.Status.UploadStatus = "processed"
+	video.Status.PrivacyStatus = "public"
+	video.Status.Embeddable = true
+
+	return video
+}
+
+func TestYouTubeProviderVideoToFetchedTrack(t *testing.T) {
+	provider := NewYouTubeProvider("key")
+
+	playableVideo := generatePlayableYoutubeVideo("dQw4w9WgXcQ")
+	assert.Equal(t, FetchedTrack{
+		ID:     "dQw4w9WgXcQ",
+		Status: shared.TrackFetchStatusFound,
+		Metadata: shared.TrackMetadata{
+			ID:         "dQw4w9WgXcQ",
+			Title:      "Title",
+			ArtistName: "Channel",
+			Duration:   3*time.Minute + 33*time.Second,
+		},
+	}, provider.videoToFetchedTrack(playableVideo))
+
+	notEmbeddableVideo := generatePlayableYoutubeVideo("dQw4w9WgXcQ")
+	notEmbeddableVideo.Status.Embeddable = false
+	assert.Equal(t, shared.TrackFetchStatusUnplayable, provider.videoToFetchedTrack(notEmbeddableVideo).Status)
+
+	privateVideo := generatePlayableYoutubeVideo("dQw4w9WgXcQ")
+	privateVideo.Status.PrivacyStatus = "private"
+	assert.Equal(t, shared.TrackFetchStatusUnplayable, provider.videoToFetchedTrack(privateVideo).Status)
+
+	rejectedVideo := generatePlayableYoutubeVideo("dQw4w9WgXcQ")
+	rejectedVideo.Status.UploadStatus = "rejected"
+	assert.Equal(t, shared.TrackFetchStatusUnplayable, provider.videoToFetchedTrack(rejectedVideo).Status)
+
+	liveVideo := generatePlayableYoutubeVideo("dQw4w9WgXcQ")
+	liveVideo.Snippet.LiveBroadcastContent = "live"
+	liveVideo.ContentDetails.Duration = "P0D"
+	assert.Equal(t, FetchedTrack{
+		ID:               "dQw4w9WgXcQ",
+		Status:           shared.TrackFetchStatusUnplayable,
+		UnplayableReason: string(youtube.UnplayableReasonLiveBroadcast),
+	}, provider.videoToFetchedTrack(liveVideo))
+
+	invalidDurationVideo := generatePlayableYoutubeVideo("dQw4w9WgXcQ")
+	invalidDurationVideo.ContentDetails.Duration = "3 minutes"
+	fetchedTrack := provider.videoToFetchedTrack(invalidDurationVideo)
+	assert.Equal(t, shared.TrackFetchStatusDurationParseError, fetchedTrack.Status)
+	assert.Empty(t, fetchedTrack.Metadata)
+}
+
+func TestYouTubeProviderAppliesItsPlayabilityPolicy(t *testing.T) {
+	ageRestrictedVideo := generatePlayableYoutubeVideo("dQw4w9WgXcQ")
+	ageRestrictedVideo.ContentDetails.ContentRating.YtRating = "ytAgeRestricted"
+
+	strictProvider := NewYouTubeProvider("key")
+	assert.Equal(t, shared.TrackFetchStatusUnplayable, strictProvider.videoToFetchedTrack(ageRestrictedVideo).Status)
+
+	lenientProvider := NewYouTubeProviderWithPolicy("key", youtube.PlayabilityPolicy{
+		AllowAgeRestricted: true,
+	})
+	assert.Equal(t, shared.TrackFetchStatusFound, lenientProvider.videoToFetchedTrack(ageRestrictedVideo).Status)
+}
@@ -12,6 +12,70 @@ type TrackMetadata struct {
 	Title      string        `json:"title"`
 	ArtistName string        `json:"artistName"`
 	Duration   time.Duration `json:"duration"`
+	//Set for the tracks fetched from a provider, so that clients
+	//know how to play tracks that do not come from YouTube
+	Provider string `json:"provider,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+//Outcome of the fetching of a single track metadata
+type TrackFetchStatus string
+
+const (
+	TrackFetchStatusFound              TrackFetchStatus = "FOUND"
+	TrackFetchStatusNotFound           TrackFetchStatus = "NOT_FOUND"
+	TrackFetchStatusUnplayable         TrackFetchStatus = "UNPLAYABLE"
+	TrackFetchStatusDurationParseError TrackFetchStatus = "DURATION_PARSE_ERROR"
+	TrackFetchStatusInvalidID          TrackFetchStatus = "INVALID_ID"
+)
+
+type TrackFetchResult struct {
+	TrackID string           `json:"trackID"`
+	Status  TrackFetchStatus `json:"status"`
+	//Only set for unplayable tracks, as LIVE_BROADCAST or REGION_BLOCKED
+	UnplayableReason string `json:"unplayableReason,omitempty"`
+}
+
+//Returns the results of the tracks that could not be fetched, in the same order
+func UnresolvedTrackFetchResults(results []TrackFetchResult) []TrackFetchResult {
+	unresolved := make([]TrackFetchResult, 0)
+
+	for _, result := range results {
+		if result.Status == TrackFetchStatusFound {
+			continue
+		}
+
+		unresolved = append(unresolved, result)
+	}
+
+	return unresolved
+}
+
+//Returns the indexes of the items of the requested page of a list stored
+//from the oldest to the most recent item, most recent item first,
+//and whether there are more items after this page.
+//Pages start at 1.
+func MostRecentFirstPageIndexes(length int, page int, limit int) ([]int, bool) {
+	indexes := make([]int, 0)
+	if page < 1 || limit < 1 {
+		return indexes, false
+	}
+
+	start := (page - 1) * limit
+	if start >= length {
+		return indexes, false
+	}
+
+	end := start + limit
+	if end > length {
+		end = length
+	}
+
+	for index := start; index < end; index++ {
+		indexes = append(indexes, length-1-index)
+	}
+
+	return indexes, end < length
 }
 
 //Custom config for mapstructure time.Time
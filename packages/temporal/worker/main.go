@@ -14,6 +14,7 @@ import (
 	mpe "github.com/AdonisEnProvence/MusicRoom/mpe/workflows"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	mtv "github.com/AdonisEnProvence/MusicRoom/mtv/workflows"
+	"github.com/AdonisEnProvence/MusicRoom/providers"
 )
 
 func main() {
@@ -28,6 +29,19 @@ func main() {
 	// This worker hosts both Worker and Activity functions
 	w := worker.New(c, shared_mtv.ControlTaskQueue, worker.Options{})
 
+	tracksProviders, err := providers.NewRegistryFromEnv()
+	if err != nil {
+		log.Fatalln("unable to create tracks providers registry", err)
+	}
+	activities.SetTracksProvidersRegistry(tracksProviders)
+
+	tracksMetadataCache, err := activities.NewTracksMetadataCacheFromEnv()
+	if err != nil {
+		log.Fatalln("unable to create tracks metadata cache", err)
+	}
+	defer tracksMetadataCache.Close()
+	activities.SetTracksMetadataCache(tracksMetadataCache)
+
 	// Common activities
 	w.RegisterActivity(activities.FetchTracksInformationActivity)
 	w.RegisterActivity(activities.FetchTracksInformationActivityAndForwardInitiator)
@@ -51,4 +65,7 @@ func main() {
 	if err != nil {
 		log.Fatalln("unable to start Worker", err)
 	}
+
+	cacheStats := tracksMetadataCache.Stats()
+	log.Printf("tracks metadata cache: %d hits, %d misses\n", cacheStats.Hits, cacheStats.Misses)
 }
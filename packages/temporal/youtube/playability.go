@@ -0,0 +1,137 @@
+package youtube
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senseyeio/duration"
+)
+
+type UnplayableReason string
+
+const (
+	UnplayableReasonNotEmbeddable UnplayableReason = "NOT_EMBEDDABLE"
+	UnplayableReasonPrivate       UnplayableReason = "PRIVATE"
+	UnplayableReasonNotProcessed  UnplayableReason = "NOT_PROCESSED"
+	UnplayableReasonLiveBroadcast UnplayableReason = "LIVE_BROADCAST"
+	UnplayableReasonAgeRestricted UnplayableReason = "AGE_RESTRICTED"
+	UnplayableReasonRegionBlocked UnplayableReason = "REGION_BLOCKED"
+	UnplayableReasonZeroDuration  UnplayableReason = "ZERO_DURATION"
+)
+
+const ageRestrictedYtRating = "ytAgeRestricted"
+
+var unprocessedUploadStatuses = map[string]bool{
+	"deleted":  true,
+	"failed":   true,
+	"rejected": true,
+}
+
+//Rooms play the videos in the embedded player, the zero value of the policy
+//rejects every video the embedded player can not play for all the users.
+//Videos that are not embeddable, private, not processed, live broadcasts
+//or without duration are always rejected: live broadcasts report a P0D
+//duration and rooms can only schedule tracks of a known duration.
+type PlayabilityPolicy struct {
+	//ISO 3166-1 alpha-2 code of the region the videos must be available in,
+	//the region restrictions are ignored when empty
+	RegionCode         string
+	AllowAgeRestricted bool
+}
+
+//Reads YOUTUBE_REGION_CODE and YOUTUBE_ALLOW_AGE_RESTRICTED
+func PlayabilityPolicyFromEnv() (PlayabilityPolicy, error) {
+	policy := PlayabilityPolicy{
+		RegionCode: strings.ToUpper(os.Getenv("YOUTUBE_REGION_CODE")),
+	}
+
+	var err error
+	if policy.AllowAgeRestricted, err = parseBoolEnv("YOUTUBE_ALLOW_AGE_RESTRICTED"); err != nil {
+		return PlayabilityPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func parseBoolEnv(key string) (bool, error) {
+	rawValue := os.Getenv(key)
+	if rawValue == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(rawValue)
+}
+
+//Returns an empty reason when the video is playable
+func (p PlayabilityPolicy) UnplayableReason(video YoutubeVideo) UnplayableReason {
+	switch {
+	case !video.Status.Embeddable:
+		return UnplayableReasonNotEmbeddable
+	case video.Status.PrivacyStatus == "private":
+		return UnplayableReasonPrivate
+	case unprocessedUploadStatuses[video.Status.UploadStatus]:
+		return UnplayableReasonNotProcessed
+	case isLiveBroadcast(video):
+		return UnplayableReasonLiveBroadcast
+	case !p.AllowAgeRestricted && video.ContentDetails.ContentRating.YtRating == ageRestrictedYtRating:
+		return UnplayableReasonAgeRestricted
+	case p.RegionCode != "" && isBlockedInRegion(video, p.RegionCode):
+		return UnplayableReasonRegionBlocked
+	case hasZeroDuration(video):
+		return UnplayableReasonZeroDuration
+	}
+
+	return ""
+}
+
+func isLiveBroadcast(video YoutubeVideo) bool {
+	liveBroadcastContent := video.Snippet.LiveBroadcastContent
+
+	return liveBroadcastContent == "live" || liveBroadcastContent == "upcoming"
+}
+
+func isBlockedInRegion(video YoutubeVideo, regionCode string) bool {
+	restriction := video.ContentDetails.RegionRestriction
+	if restriction == nil {
+		return false
+	}
+
+	if restriction.Allowed != nil && !containsRegionCode(restriction.Allowed, regionCode) {
+		return true
+	}
+
+	return containsRegionCode(restriction.Blocked, regionCode)
+}
+
+func containsRegionCode(regionCodes []string, regionCode string) bool {
+	for _, code := range regionCodes {
+		if strings.EqualFold(code, regionCode) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//Live broadcasts are rejected beforehand, a duration that can not be parsed
+//is not considered as zero, it is reported by ParseVideoDuration
+func hasZeroDuration(video YoutubeVideo) bool {
+	parsedDuration, err := ParseVideoDuration(video)
+
+	return err == nil && parsedDuration <= 0
+}
+
+//Parses the ISO 8601 duration of the video, as PT3M33S
+func ParseVideoDuration(video YoutubeVideo) (time.Duration, error) {
+	parsedDuration, err := duration.ParseISO8601(video.ContentDetails.Duration)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	appliedDuration := parsedDuration.Shift(now)
+
+	return appliedDuration.Sub(now), nil
+}
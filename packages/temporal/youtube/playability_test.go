@@ -0,0 +1,144 @@
+package youtube
+
+import (
+	"os"
+	"time"
+)
+
+func (s *UnitTestSuite) Test_PlayabilityPolicyRejectsUnplayableVideos() {
+	strictPolicy := PlayabilityPolicy{
+		RegionCode: "FR",
+	}
+
+	testCases := []struct {
+		name           string
+		updateVideo    func(video *YoutubeVideo)
+		expectedReason UnplayableReason
+	}{
+		{
+			name:           "playable video",
+			updateVideo:    func(video *YoutubeVideo) {},
+			expectedReason: "",
+		},
+		{
+			name: "not embeddable video",
+			updateVideo: func(video *YoutubeVideo) {
+				video.Status.Embeddable = false
+			},
+			expectedReason: UnplayableReasonNotEmbeddable,
+		},
+		{
+			name: "private video",
+			updateVideo: func(video *YoutubeVideo) {
+				video.Status.PrivacyStatus = "private"
+			},
+			expectedReason: UnplayableReasonPrivate,
+		},
+		{
+			name: "deleted video",
+			updateVideo: func(video *YoutubeVideo) {
+				video.Status.UploadStatus = "deleted"
+			},
+			expectedReason: UnplayableReasonNotProcessed,
+		},
+		{
+			name: "upcoming live broadcast",
+			updateVideo: func(video *YoutubeVideo) {
+				video.Snippet.LiveBroadcastContent = "upcoming"
+			},
+			expectedReason: UnplayableReasonLiveBroadcast,
+		},
+		{
+			name: "age restricted video",
+			updateVideo: func(video *YoutubeVideo) {
+				video.ContentDetails.ContentRating.YtRating = ageRestrictedYtRating
+			},
+			expectedReason: UnplayableReasonAgeRestricted,
+		},
+		{
+			name: "video blocked in the region",
+			updateVideo: func(video *YoutubeVideo) {
+				video.ContentDetails.RegionRestriction = &YoutubeVideoRegionRestriction{
+					Blocked: []string{"DE", "fr"},
+				}
+			},
+			expectedReason: UnplayableReasonRegionBlocked,
+		},
+		{
+			name: "video only allowed in other regions",
+			updateVideo: func(video *YoutubeVideo) {
+				video.ContentDetails.RegionRestriction = &YoutubeVideoRegionRestriction{
+					Allowed: []string{"US"},
+				}
+			},
+			expectedReason: UnplayableReasonRegionBlocked,
+		},
+		{
+			name: "zero duration video",
+			updateVideo: func(video *YoutubeVideo) {
+				video.ContentDetails.Duration = "P0D"
+			},
+			expectedReason: UnplayableReasonZeroDuration,
+		},
+		{
+			name: "video with an invalid duration",
+			updateVideo: func(video *YoutubeVideo) {
+				video.ContentDetails.Duration = "3 minutes"
+			},
+			expectedReason: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		video := generateYoutubeVideo("dQw4w9WgXcQ")
+		testCase.updateVideo(&video)
+
+		s.Equal(testCase.expectedReason, strictPolicy.UnplayableReason(video), testCase.name)
+	}
+}
+
+func (s *UnitTestSuite) Test_PlayabilityPolicyCanAllowRestrictedVideos() {
+	video := generateYoutubeVideo("dQw4w9WgXcQ")
+	video.ContentDetails.ContentRating.YtRating = ageRestrictedYtRating
+	video.ContentDetails.RegionRestriction = &YoutubeVideoRegionRestriction{
+		Blocked: []string{"FR"},
+	}
+
+	lenientPolicy := PlayabilityPolicy{
+		AllowAgeRestricted: true,
+	}
+	s.Equal(UnplayableReason(""), lenientPolicy.UnplayableReason(video))
+
+	//Live broadcasts have no duration rooms could play them for
+	video.Snippet.LiveBroadcastContent = "live"
+	video.ContentDetails.Duration = "P0D"
+	s.Equal(UnplayableReasonLiveBroadcast, lenientPolicy.UnplayableReason(video))
+}
+
+func (s *UnitTestSuite) Test_PlayabilityPolicyFromEnv() {
+	os.Setenv("YOUTUBE_REGION_CODE", "fr")
+	os.Setenv("YOUTUBE_ALLOW_AGE_RESTRICTED", "true")
+	defer func() {
+		os.Unsetenv("YOUTUBE_REGION_CODE")
+		os.Unsetenv("YOUTUBE_ALLOW_AGE_RESTRICTED")
+	}()
+
+	policy, err := PlayabilityPolicyFromEnv()
+	s.NoError(err)
+	s.Equal(PlayabilityPolicy{
+		RegionCode:         "FR",
+		AllowAgeRestricted: true,
+	}, policy)
+
+	os.Setenv("YOUTUBE_ALLOW_AGE_RESTRICTED", "maybe")
+	_, err = PlayabilityPolicyFromEnv()
+	s.Error(err)
+}
+
+func (s *UnitTestSuite) Test_ParseVideoDuration() {
+	video := generateYoutubeVideo("dQw4w9WgXcQ")
+
+	parsedDuration, err := ParseVideoDuration(video)
+	s.NoError(err)
+	s.Equal(3*time.Minute+33*time.Second, parsedDuration)
+}
@@ -3,44 +3,76 @@ package youtube
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 )
 
+const (
+	//videos.list endpoint does not accept more ids in a single request
+	MaxVideosIDsPerRequest = 50
+	maxConcurrentRequests  = 4
+)
+
+//Variable to be able to target a fake server in tests
+var videosEndpointBaseURL = "https://youtube.googleapis.com/youtube/v3/videos"
+
+type YoutubeVideo struct {
+	Kind    string `json:"kind" validate:"required"`
+	ID      string `json:"id" validate:"required"`
+	Snippet struct {
+		Title       string `json:"title" validate:"required"`
+		Description string `json:"description"`
+		Thumbnails  struct {
+			Default struct {
+				URL    string `json:"url"`
+				Width  int    `json:"width"`
+				Height int    `json:"height"`
+			} `json:"default"`
+		} `json:"thumbnails"`
+		ChannelTitle string `json:"channelTitle" validate:"required"`
+		CategoryID   string `json:"categoryId"`
+		//none, live or upcoming
+		LiveBroadcastContent string `json:"liveBroadcastContent"`
+	} `json:"snippet" validate:"required"`
+	ContentDetails struct {
+		Duration string `json:"duration" validate:"required"`
+		//Absent when the video is available everywhere
+		RegionRestriction *YoutubeVideoRegionRestriction `json:"regionRestriction"`
+		ContentRating     struct {
+			YtRating string `json:"ytRating"`
+		} `json:"contentRating"`
+	} `json:"contentDetails" validate:"required"`
+	Status struct {
+		UploadStatus  string `json:"uploadStatus"`
+		PrivacyStatus string `json:"privacyStatus"`
+		Embeddable    bool   `json:"embeddable"`
+	} `json:"status"`
+}
+
+type YoutubeVideoRegionRestriction struct {
+	//Only these regions can play the video when the list is present, even empty
+	Allowed []string `json:"allowed"`
+	Blocked []string `json:"blocked"`
+}
+
 type YoutubeVideosListAPIResponse struct {
-	Kind  string `json:"kind" validate:"required"`
-	Items []struct {
-		Kind    string `json:"kind" validate:"required"`
-		ID      string `json:"id" validate:"required"`
-		Snippet struct {
-			Title       string `json:"title" validate:"required"`
-			Description string `json:"description" validate:"required"`
-			Thumbnails  struct {
-				Default struct {
-					URL    string `json:"url" validate:"required"`
-					Width  int    `json:"width" validate:"required"`
-					Height int    `json:"height" validate:"required"`
-				} `json:"default" validate:"required"`
-			} `json:"thumbnails" validate:"required"`
-			ChannelTitle string `json:"channelTitle" validate:"required"`
-			CategoryID   string `json:"categoryId"`
-		} `json:"snippet" validate:"required"`
-		ContentDetails struct {
-			Duration string `json:"duration" validate:"required"`
-		} `json:"contentDetails" validate:"required"`
-	} `json:"items" validate:"required"`
+	Kind  string         `json:"kind" validate:"required"`
+	Items []YoutubeVideo `json:"items" validate:"required"`
+	//Counts are zero when none of the requested videos exists
 	PageInfo struct {
-		TotalResults   int `json:"totalResults" validate:"required"`
-		ResultsPerPage int `json:"resultsPerPage" validate:"required"`
-	} `json:"pageInfo" validate:"required"`
+		TotalResults   int `json:"totalResults"`
+		ResultsPerPage int `json:"resultsPerPage"`
+	} `json:"pageInfo"`
 }
 
 func computeYouTubeVideosEndpointURL(apiKey string, videosIDs []string) string {
-	const BaseUrl = "https://youtube.googleapis.com/youtube/v3/videos"
 	var PartsToGet = []string{
 		"snippet",
 		"contentDetails",
+		"status",
 	}
 
 	joinedPartsToGet := strings.Join(PartsToGet, ",")
@@ -50,18 +82,28 @@ func computeYouTubeVideosEndpointURL(apiKey string, videosIDs []string) string {
 		"key":  {apiKey},
 	}
 
-	// As https://youtube.googleapis.com/youtube/v3/videos?part=snippet%2CcontentDetails&id=Ks-_Mh1QhMc&id=9Tfciw7QM3c&key=[API_KEY]
-	return BaseUrl + "?" + params.Encode()
+	// As https://youtube.googleapis.com/youtube/v3/videos?part=snippet%2CcontentDetails%2Cstatus&id=Ks-_Mh1QhMc&id=9Tfciw7QM3c&key=[API_KEY]
+	return videosEndpointBaseURL + "?" + params.Encode()
 }
 
+//Fetches at most MaxVideosIDsPerRequest videos, prefer FetchVideosInformation
 func FetchYouTubeVideosInformation(ctx context.Context, apiKey string, videosIDs []string) (YoutubeVideosListAPIResponse, error) {
 	url := computeYouTubeVideosEndpointURL(apiKey, videosIDs)
-	resp, err := http.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return YoutubeVideosListAPIResponse{}, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return YoutubeVideosListAPIResponse{}, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return YoutubeVideosListAPIResponse{}, fmt.Errorf("youtube videos.list request failed with status %d", resp.StatusCode)
+	}
+
 	var youtubeResponse YoutubeVideosListAPIResponse
 
 	if err := json.NewDecoder(resp.Body).Decode(&youtubeResponse); err != nil {
@@ -72,5 +114,129 @@ func FetchYouTubeVideosInformation(ctx context.Context, apiKey string, videosIDs
 		return YoutubeVideosListAPIResponse{}, err
 	}
 
+	//A single incomplete video must not fail the fetching of the others,
+	//it is dropped and reported as missing
+	validItems := make([]YoutubeVideo, 0, len(youtubeResponse.Items))
+	for _, video := range youtubeResponse.Items {
+		if err := validate.Struct(video); err != nil {
+			continue
+		}
+
+		validItems = append(validItems, video)
+	}
+	youtubeResponse.Items = validItems
+
 	return youtubeResponse, nil
 }
+
+type FetchedVideos struct {
+	//In the order of the requested ids
+	Videos []YoutubeVideo
+	//Ids YouTube did not return, because they do not exist or are private,
+	//or returned with incomplete metadata
+	MissingVideosIDs []string
+}
+
+func chunkVideosIDs(videosIDs []string, chunkSize int) [][]string {
+	chunks := make([][]string, 0, (len(videosIDs)+chunkSize-1)/chunkSize)
+
+	for start := 0; start < len(videosIDs); start += chunkSize {
+		end := start + chunkSize
+		if end > len(videosIDs) {
+			end = len(videosIDs)
+		}
+
+		chunks = append(chunks, videosIDs[start:end])
+	}
+
+	return chunks
+}
+
+func uniqueVideosIDs(videosIDs []string) []string {
+	unique := make([]string, 0, len(videosIDs))
+	alreadySeen := make(map[string]bool, len(videosIDs))
+
+	for _, videoID := range videosIDs {
+		if alreadySeen[videoID] {
+			continue
+		}
+
+		alreadySeen[videoID] = true
+		unique = append(unique, videoID)
+	}
+
+	return unique
+}
+
+//Splits the ids into requests of MaxVideosIDsPerRequest ids, with at most
+//maxConcurrentRequests requests in flight. The first failing request
+//cancels the others and its error is returned.
+func FetchVideosInformation(ctx context.Context, apiKey string, videosIDs []string) (FetchedVideos, error) {
+	requestedVideosIDs := uniqueVideosIDs(videosIDs)
+	chunks := chunkVideosIDs(requestedVideosIDs, MaxVideosIDsPerRequest)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg                sync.WaitGroup
+		mutex             sync.Mutex
+		firstErr          error
+		fetchedVideosByID = make(map[string]YoutubeVideo, len(requestedVideosIDs))
+		semaphore         = make(chan struct{}, maxConcurrentRequests)
+	)
+
+	for _, chunk := range chunks {
+		wg.Add(1)
+
+		go func(chunk []string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			response, err := FetchYouTubeVideosInformation(ctx, apiKey, chunk)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+
+			for _, video := range response.Items {
+				fetchedVideosByID[video.ID] = video
+			}
+		}(chunk)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return FetchedVideos{}, firstErr
+	}
+
+	fetchedVideos := FetchedVideos{
+		Videos:           make([]YoutubeVideo, 0, len(fetchedVideosByID)),
+		MissingVideosIDs: make([]string, 0),
+	}
+	for _, videoID := range requestedVideosIDs {
+		video, exists := fetchedVideosByID[videoID]
+		if !exists {
+			fetchedVideos.MissingVideosIDs = append(fetchedVideos.MissingVideosIDs, videoID)
+			continue
+		}
+
+		fetchedVideos.Videos = append(fetchedVideos.Videos, video)
+	}
+
+	return fetchedVideos, nil
+}
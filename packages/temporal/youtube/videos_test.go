@@ -0,0 +1,193 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+//Serves every video whose id does not start with "missing",
+//videos whose id starts with "incomplete" have no duration
+//and videos whose id starts with "undescribed" have neither description nor thumbnails
+type fakeVideosServer struct {
+	mutex              sync.Mutex
+	requestedIDsCounts []int
+	inFlightRequests   int
+	maxInFlight        int
+	failingVideoID     string
+}
+
+func (f *fakeVideosServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ids := r.URL.Query()["id"]
+
+	f.mutex.Lock()
+	f.requestedIDsCounts = append(f.requestedIDsCounts, len(ids))
+	f.inFlightRequests++
+	if f.inFlightRequests > f.maxInFlight {
+		f.maxInFlight = f.inFlightRequests
+	}
+	f.mutex.Unlock()
+
+	defer func() {
+		f.mutex.Lock()
+		f.inFlightRequests--
+		f.mutex.Unlock()
+	}()
+
+	//Leaves time for the other requests to start
+	time.Sleep(5 * time.Millisecond)
+
+	response := YoutubeVideosListAPIResponse{
+		Kind:  "youtube#videoListResponse",
+		Items: make([]YoutubeVideo, 0, len(ids)),
+	}
+	for _, id := range ids {
+		if id == f.failingVideoID {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if strings.HasPrefix(id, "missing") {
+			continue
+		}
+
+		video := generateYoutubeVideo(id)
+		if strings.HasPrefix(id, "incomplete") {
+			video.ContentDetails.Duration = ""
+		}
+		if strings.HasPrefix(id, "undescribed") {
+			video.Snippet.Description = ""
+			video.Snippet.Thumbnails.Default.URL = ""
+			video.Snippet.Thumbnails.Default.Width = 0
+			video.Snippet.Thumbnails.Default.Height = 0
+		}
+
+		response.Items = append(response.Items, video)
+	}
+	response.PageInfo.TotalResults = len(response.Items)
+	response.PageInfo.ResultsPerPage = len(response.Items)
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func generateYoutubeVideo(id string) YoutubeVideo {
+	var video YoutubeVideo
+
+	video.Kind = "youtube#video"
+	video.ID = id
+	video.Snippet.Title = "Title " + id
+	video.Snippet.Description = "Description"
+	video.Snippet.Thumbnails.Default.URL = "https://i.ytimg.com/vi/" + id + "/default.jpg"
+	video.Snippet.Thumbnails.Default.Width = 120
+	video.Snippet.Thumbnails.Default.Height = 90
+	video.Snippet.ChannelTitle = "Channel"
+	video.Snippet.LiveBroadcastContent = "none"
+	video.ContentDetails.Duration = "PT3M33S"
+	video.Status.UploadStatus = "processed"
+	video.Status.PrivacyStatus = "public"
+	video.Status.Embeddable = true
+
+	return video
+}
+
+func (s *UnitTestSuite) startFakeServer(server *fakeVideosServer) func() {
+	httpServer := httptest.NewServer(server)
+	previousBaseURL := videosEndpointBaseURL
+	videosEndpointBaseURL = httpServer.URL
+
+	return func() {
+		videosEndpointBaseURL = previousBaseURL
+		httpServer.Close()
+	}
+}
+
+func generateVideosIDs(prefix string, count int) []string {
+	ids := make([]string, 0, count)
+
+	for index := 0; index < count; index++ {
+		ids = append(ids, fmt.Sprintf("%s-%d", prefix, index))
+	}
+
+	return ids
+}
+
+func (s *UnitTestSuite) Test_FetchVideosInformationSplitsRequestsInChunks() {
+	server := &fakeVideosServer{}
+	stop := s.startFakeServer(server)
+	defer stop()
+
+	videosIDs := generateVideosIDs("video", 4*MaxVideosIDsPerRequest+10)
+
+	fetchedVideos, err := FetchVideosInformation(context.Background(), "key", videosIDs)
+	s.NoError(err)
+
+	s.Len(server.requestedIDsCounts, 5)
+	for _, requestedIDsCount := range server.requestedIDsCounts {
+		s.LessOrEqual(requestedIDsCount, MaxVideosIDsPerRequest)
+	}
+	s.LessOrEqual(server.maxInFlight, maxConcurrentRequests)
+
+	fetchedVideosIDs := make([]string, 0, len(fetchedVideos.Videos))
+	for _, video := range fetchedVideos.Videos {
+		fetchedVideosIDs = append(fetchedVideosIDs, video.ID)
+	}
+	s.Equal(videosIDs, fetchedVideosIDs)
+	s.Empty(fetchedVideos.MissingVideosIDs)
+}
+
+func (s *UnitTestSuite) Test_FetchVideosInformationSurfacesMissingVideos() {
+	server := &fakeVideosServer{}
+	stop := s.startFakeServer(server)
+	defer stop()
+
+	videosIDs := append(generateVideosIDs("video", MaxVideosIDsPerRequest), "missing-1", "video-0", "missing-2")
+
+	fetchedVideos, err := FetchVideosInformation(context.Background(), "key", videosIDs)
+	s.NoError(err)
+
+	s.Len(fetchedVideos.Videos, MaxVideosIDsPerRequest)
+	s.Equal("video-0", fetchedVideos.Videos[0].ID)
+	s.Equal([]string{"missing-1", "missing-2"}, fetchedVideos.MissingVideosIDs)
+}
+
+func (s *UnitTestSuite) Test_FetchVideosInformationReportsIncompleteVideosAsMissing() {
+	server := &fakeVideosServer{}
+	stop := s.startFakeServer(server)
+	defer stop()
+
+	videosIDs := []string{"video-0", "incomplete-1", "undescribed-2"}
+
+	fetchedVideos, err := FetchVideosInformation(context.Background(), "key", videosIDs)
+	s.NoError(err)
+
+	s.Len(fetchedVideos.Videos, 2)
+	s.Equal("video-0", fetchedVideos.Videos[0].ID)
+	s.Equal("undescribed-2", fetchedVideos.Videos[1].ID)
+	s.Equal([]string{"incomplete-1"}, fetchedVideos.MissingVideosIDs)
+}
+
+func (s *UnitTestSuite) Test_FetchVideosInformationFailsWhenAChunkFails() {
+	server := &fakeVideosServer{
+		failingVideoID: "video-120",
+	}
+	stop := s.startFakeServer(server)
+	defer stop()
+
+	_, err := FetchVideosInformation(context.Background(), "key", generateVideosIDs("video", 3*MaxVideosIDsPerRequest))
+	s.Error(err)
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}